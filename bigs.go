@@ -0,0 +1,399 @@
+package typ
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+)
+
+var (
+	bigIntType   = reflect.TypeOf(big.Int{})
+	bigFloatType = reflect.TypeOf(big.Float{})
+	bigRatType   = reflect.TypeOf(big.Rat{})
+)
+
+// BigInt convert interface value to *big.Int.
+// Strings are parsed with base prefixes, float values must be integral unless Rounding option is set.
+// Returns value if type can safely converted, otherwise error & default value in result values
+func (t *Type) BigInt(defaultValue ...*big.Int) BigIntAccessor {
+	nv := &NullBigInt{}
+	if nv.Error = t.err; t.err != nil {
+		return nv
+	}
+	nv = t.toBigInt()
+	defaultBigInt(nv, defaultValue...)
+	return nv
+}
+
+// Convert interface value to *big.Int.
+// Returns error if type can't safely converted
+func (t *Type) toBigInt() *NullBigInt {
+	nv := &NullBigInt{}
+	if t.IsString(true) {
+		if v, ok := new(big.Int).SetString(strings.TrimSpace(t.rv.String()), 0); ok {
+			nv.P = v
+			return nv
+		}
+	}
+	var f *big.Float
+	if r := t.toBigRat(); r.Err() == nil {
+		if r.V().IsInt() {
+			nv.P = new(big.Int).Set(r.V().Num())
+			return nv
+		}
+		f = new(big.Float).SetRat(r.V())
+	} else {
+		bf := t.toBigFloat(0)
+		if bf.Err() != nil {
+			nv.Error = t.bigWrapError(reflect.PtrTo(bigIntType), bf.Err())
+			return nv
+		}
+		f = bf.V()
+	}
+	v, ok := bigFloatInt(f, t.opts.rounding)
+	if !ok {
+		reason := ReasonPrecision
+		if f.IsInf() {
+			reason = ReasonOverflow
+		}
+		nv.Error = t.bigConversionError(reflect.PtrTo(bigIntType), reason, nil)
+		return nv
+	}
+	nv.P = v
+	return nv
+}
+
+// BigFloat convert interface value to *big.Float with given precision in bits.
+// If prec is 0, precision is chosen by source value as math/big does, strings get precision of all their digits.
+// Returns value if type can safely converted, otherwise error & default value in result values
+func (t *Type) BigFloat(prec uint, defaultValue ...*big.Float) BigFloatAccessor {
+	nv := &NullBigFloat{}
+	if nv.Error = t.err; t.err != nil {
+		return nv
+	}
+	nv = t.toBigFloat(prec)
+	defaultBigFloat(nv, defaultValue...)
+	return nv
+}
+
+// Convert interface value to *big.Float.
+// Returns error if type can't safely converted
+func (t *Type) toBigFloat(prec uint) *NullBigFloat {
+	nv := &NullBigFloat{}
+	if !t.rv.IsValid() {
+		nv.Error = t.bigConversionError(reflect.PtrTo(bigFloatType), ReasonUnsupported, nil)
+		return nv
+	}
+	v := new(big.Float).SetPrec(prec)
+	switch {
+	case t.rv.Type() == bigIntType:
+		v.SetInt(bigPointer(t.rv).(*big.Int))
+	case t.rv.Type() == bigFloatType:
+		v.Set(bigPointer(t.rv).(*big.Float))
+	case t.rv.Type() == bigRatType:
+		v.SetRat(bigPointer(t.rv).(*big.Rat))
+	case t.rv.Type() == decimalType:
+		s := t.rv.Interface().(Decimal).String()
+		if prec == 0 {
+			v.SetPrec(decimalPrec(s))
+		}
+		v.Parse(s, 10)
+	case t.IsString(true):
+		s := strings.TrimSpace(t.rv.String())
+		if prec == 0 {
+			v.SetPrec(decimalPrec(s))
+		}
+		if _, _, err := v.Parse(s, 0); err != nil {
+			nv.Error = t.bigConversionError(reflect.PtrTo(bigFloatType), ReasonSyntax, err)
+			return nv
+		}
+	case t.IsInt(true):
+		v.SetInt64(t.rv.Int())
+	case t.IsUint(true):
+		v.SetUint64(t.rv.Uint())
+	case t.IsFloat(true) && !math.IsNaN(t.rv.Float()):
+		v.SetFloat64(t.rv.Float())
+	case t.IsComplex(true) && imag(t.rv.Complex()) == 0 && !math.IsNaN(real(t.rv.Complex())):
+		v.SetFloat64(real(t.rv.Complex()))
+	case t.IsFloat(true) || t.IsComplex(true) && imag(t.rv.Complex()) == 0:
+		nv.Error = t.bigConversionError(reflect.PtrTo(bigFloatType), ReasonPrecision, nil)
+		return nv
+	case t.IsComplex(true):
+		nv.Error = t.bigConversionError(reflect.PtrTo(bigFloatType), ReasonImaginary, nil)
+		return nv
+	default:
+		nv.Error = t.bigConversionError(reflect.PtrTo(bigFloatType), ReasonUnsupported, nil)
+		return nv
+	}
+	nv.P = v
+	return nv
+}
+
+// BigRat convert interface value to *big.Rat.
+// Strings are parsed as fractions ("1/3") or decimals ("0.125"), infinite values can't be converted.
+// Returns value if type can safely converted, otherwise error & default value in result values
+func (t *Type) BigRat(defaultValue ...*big.Rat) BigRatAccessor {
+	nv := &NullBigRat{}
+	if nv.Error = t.err; t.err != nil {
+		return nv
+	}
+	nv = t.toBigRat()
+	defaultBigRat(nv, defaultValue...)
+	return nv
+}
+
+// Convert interface value to *big.Rat.
+// Returns error if type can't safely converted
+func (t *Type) toBigRat() *NullBigRat {
+	nv := &NullBigRat{}
+	switch {
+	case t.rv.IsValid() && t.rv.Type() == bigRatType:
+		nv.P = new(big.Rat).Set(bigPointer(t.rv).(*big.Rat))
+		return nv
+	case t.rv.IsValid() && t.rv.Type() == decimalType:
+		nv.P = t.rv.Interface().(Decimal).Rat()
+		return nv
+	case t.IsString(true):
+		if v, ok := new(big.Rat).SetString(strings.TrimSpace(t.rv.String())); ok {
+			nv.P = v
+			return nv
+		}
+	}
+	f := t.toBigFloat(0)
+	if f.Err() != nil {
+		nv.Error = t.bigWrapError(reflect.PtrTo(bigRatType), f.Err())
+		return nv
+	}
+	if f.V().IsInf() {
+		nv.Error = t.bigConversionError(reflect.PtrTo(bigRatType), ReasonOverflow, nil)
+		return nv
+	}
+	nv.P, _ = f.V().Rat(nil)
+	return nv
+}
+
+// Returns rational value of big number or Decimal, false is returned for other values and infinite big floats
+func numberRat(rv reflect.Value) (*big.Rat, bool) {
+	if rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return nil, false
+	}
+	switch rv.Type() {
+	case bigIntType:
+		return new(big.Rat).SetInt(bigPointer(rv).(*big.Int)), true
+	case bigFloatType:
+		if f := bigPointer(rv).(*big.Float); !f.IsInf() {
+			r, _ := f.Rat(nil)
+			return r, true
+		}
+	case bigRatType:
+		return bigPointer(rv).(*big.Rat), true
+	case decimalType:
+		return rv.Interface().(Decimal).Rat(), true
+	}
+	return nil, false
+}
+
+// Returns conversion error of value to big number or Decimal type with given reason
+func (t *Type) bigConversionError(typeTo reflect.Type, reason ConversionReason, err error) error {
+	ce := t.newConversionError(reflect.Struct, err)
+	ce.ToType, ce.Reason = typeTo, reason
+	return ce
+}
+
+// Returns conversion error of big number type from error of underlying conversion
+func (t *Type) bigWrapError(typeTo reflect.Type, err error) error {
+	if ce, ok := err.(*ConversionError); ok {
+		return t.bigConversionError(typeTo, ce.Reason, ce.Err)
+	}
+	return err
+}
+
+// Returns pointer to big number of value, value is copied if it isn't addressable
+func bigPointer(rv reflect.Value) interface{} {
+	if rv.CanAddr() {
+		return rv.Addr().Interface()
+	}
+	p := reflect.New(rv.Type())
+	p.Elem().Set(rv)
+	return p.Interface()
+}
+
+// Returns the narrowest exact Go value of big number, e.g. int64, uint64 or float64.
+// Returns false if value doesn't fit any of them
+func bigValue(rv reflect.Value) (interface{}, bool) {
+	switch v := bigPointer(rv).(type) {
+	case *big.Int:
+		return bigIntValue(v)
+	case *big.Float:
+		if v.IsInt() && !v.IsInf() {
+			i, _ := v.Int(nil)
+			return bigIntValue(i)
+		}
+		if f, accuracy := v.Float64(); accuracy == big.Exact {
+			return f, true
+		}
+	case *big.Rat:
+		if v.IsInt() {
+			return bigIntValue(v.Num())
+		}
+		if f, exact := v.Float64(); exact {
+			return f, true
+		}
+	}
+	return nil, false
+}
+
+// Returns int64, uint64 or float64 value of big integer, false is returned if value doesn't fit them
+func bigIntValue(v *big.Int) (interface{}, bool) {
+	switch {
+	case v.IsInt64():
+		return v.Int64(), true
+	case v.IsUint64():
+		return v.Uint64(), true
+	}
+	if f, accuracy := new(big.Float).SetInt(v).Float64(); accuracy == big.Exact {
+		return f, true
+	}
+	return nil, false
+}
+
+// Returns string representation of big number or Decimal
+func bigString(rv reflect.Value) (string, bool) {
+	if !rv.IsValid() {
+		return "", false
+	}
+	switch rv.Type() {
+	case decimalType:
+		return rv.Interface().(Decimal).String(), true
+	case bigIntType, bigFloatType, bigRatType:
+	default:
+		return "", false
+	}
+	switch v := bigPointer(rv).(type) {
+	case *big.Int:
+		return v.String(), true
+	case *big.Float:
+		return v.Text('g', -1), true
+	case *big.Rat:
+		return v.RatString(), true
+	}
+	return "", false
+}
+
+// Returns integer value of big float rounded by rounding mode.
+// Returns false if value is infinite or it has fractional part and rounding mode is RoundNone
+func bigFloatInt(f *big.Float, mode RoundingMode) (*big.Int, bool) {
+	if f.IsInf() {
+		return nil, false
+	}
+	v, accuracy := f.Int(nil)
+	if accuracy == big.Exact {
+		return v, true
+	}
+	one := big.NewInt(1)
+	switch mode {
+	case RoundNearest:
+		half := new(big.Float).SetFloat64(0.5)
+		if f.Signbit() {
+			half.Neg(half)
+		}
+		v, _ = new(big.Float).SetPrec(f.Prec()+1).Add(f, half).Int(nil)
+	case RoundFloor:
+		if f.Signbit() {
+			v.Sub(v, one)
+		}
+	case RoundCeil:
+		if !f.Signbit() {
+			v.Add(v, one)
+		}
+	case RoundTruncate:
+	default:
+		return nil, false
+	}
+	return v, true
+}
+
+// Returns integer value of big rat rounded exactly by rounding mode.
+// Returns false if value has fractional part and rounding mode is RoundNone
+func bigRatInt(r *big.Rat, mode RoundingMode) (*big.Int, bool) {
+	v, rem := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if rem.Sign() == 0 {
+		return v, true
+	}
+	step := big.NewInt(int64(r.Sign()))
+	switch mode {
+	case RoundNearest:
+		if new(big.Int).Lsh(rem.Abs(rem), 1).Cmp(r.Denom()) >= 0 {
+			v.Add(v, step)
+		}
+	case RoundFloor:
+		if r.Sign() < 0 {
+			v.Add(v, step)
+		}
+	case RoundCeil:
+		if r.Sign() > 0 {
+			v.Add(v, step)
+		}
+	case RoundTruncate:
+	default:
+		return nil, false
+	}
+	return v, true
+}
+
+// Returns precision in bits enough for all decimal digits of number, but not less than 64
+func decimalPrec(s string) uint {
+	digits := 0
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			digits++
+		}
+	}
+	if prec := uint(math.Ceil(float64(digits)*math.Log2(10))) + 1; prec > 64 {
+		return prec
+	}
+	return 64
+}
+
+// Set default big int value if value isn't valid
+func defaultBigInt(nv *NullBigInt, defaultValue ...*big.Int) bool {
+	if len(defaultValue) > 1 {
+		nv.Error = ErrDefaultValue
+		return true
+	}
+	if !nv.Valid() && len(defaultValue) > 0 {
+		nv.Set(defaultValue[0])
+		return true
+	}
+	return false
+}
+
+// Set default big float value if value isn't valid
+func defaultBigFloat(nv *NullBigFloat, defaultValue ...*big.Float) bool {
+	if len(defaultValue) > 1 {
+		nv.Error = ErrDefaultValue
+		return true
+	}
+	if !nv.Valid() && len(defaultValue) > 0 {
+		nv.Set(defaultValue[0])
+		return true
+	}
+	return false
+}
+
+// Set default big rat value if value isn't valid
+func defaultBigRat(nv *NullBigRat, defaultValue ...*big.Rat) bool {
+	if len(defaultValue) > 1 {
+		nv.Error = ErrDefaultValue
+		return true
+	}
+	if !nv.Valid() && len(defaultValue) > 0 {
+		nv.Set(defaultValue[0])
+		return true
+	}
+	return false
+}
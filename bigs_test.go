@@ -0,0 +1,183 @@
+package typ
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestBigSource(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	testData := []struct {
+		value    interface{}
+		expected int64
+		err      error
+	}{
+		{big.NewInt(42), 42, nil},
+		{*big.NewInt(-42), -42, nil},
+		{big.NewInt(math.MaxInt64), math.MaxInt64, nil},
+		{new(big.Int).SetUint64(math.MaxUint64), 0, ErrConvert},
+		{huge, 0, ErrConvert},
+		{big.NewFloat(7), 7, nil},
+		{big.NewFloat(7.5), 0, ErrConvert},
+		{new(big.Float).SetInf(false), 0, ErrConvert},
+		{big.NewRat(14, 2), 7, nil},
+		{big.NewRat(1, 3), 0, ErrConvert},
+	}
+	for _, v := range testData {
+		nv := Of(v.value).Int64()
+		if nv.V() != v.expected && v.err == nil || !errors.Is(nv.Err(), v.err) {
+			t.Errorf("Of(%v).Int64() failed, expected (expected == actual) %v == %v, error %v == %v",
+				v.value, v.expected, nv.V(), v.err, nv.Err(),
+			)
+		}
+	}
+	if nv := Of(new(big.Int).SetUint64(math.MaxUint64)).Uint64(); nv.V() != math.MaxUint64 || nv.Err() != nil {
+		t.Errorf("Of(big.Int).Uint64() failed, expected %v instead of %v, error %v", uint64(math.MaxUint64), nv.V(), nv.Err())
+	}
+	if nv := Of(huge).Int8(); !errors.Is(nv.Err(), ErrConvert) {
+		t.Errorf("Of(%v).Int8() failed, expected error %v instead of %v", huge, ErrConvert, nv.Err())
+	}
+	var ce *ConversionError
+	if nv := Of(big.NewInt(300)).Int8(); !errors.As(nv.Err(), &ce) || ce.Reason != ReasonOverflow {
+		t.Errorf("Of(big.Int(300)).Int8() failed, expected overflow reason instead of %v", nv.Err())
+	}
+	if nv := Of(big.NewInt(300), Overflow(OverflowClamp)).Int8(); nv.V() != 127 || !nv.PrecisionLost() {
+		t.Errorf("Of(big.Int(300)).Int8() failed, expected clamped value instead of %v, error %v", nv.V(), nv.Err())
+	}
+	if nv := Of(big.NewRat(1, 4)).Float(); nv.V() != 0.25 || nv.Err() != nil {
+		t.Errorf("Of(big.Rat(1/4)).Float() failed, expected 0.25 instead of %v, error %v", nv.V(), nv.Err())
+	}
+	if nv := To[int32](big.NewInt(-5)); nv.V() != -5 || nv.Err() != nil {
+		t.Errorf("To[int32](big.Int(-5)) failed, expected -5 instead of %v, error %v", nv.V(), nv.Err())
+	}
+	for _, v := range []struct {
+		value    interface{}
+		expected string
+	}{
+		{huge, "123456789012345678901234567890"},
+		{big.NewFloat(1.5), "1.5"},
+		{big.NewRat(1, 3), "1/3"},
+	} {
+		if nv := Of(v.value).String(); nv.V() != v.expected || nv.Err() != nil {
+			t.Errorf("Of(%v).String() failed, expected %v instead of %v, error %v", v.value, v.expected, nv.V(), nv.Err())
+		}
+	}
+}
+
+func TestBigInt(t *testing.T) {
+	testData := []struct {
+		value    interface{}
+		options  []Option
+		expected string
+		err      error
+	}{
+		{42, nil, "42", nil},
+		{uint64(math.MaxUint64), nil, "18446744073709551615", nil},
+		{"123456789012345678901234567890", nil, "123456789012345678901234567890", nil},
+		{" 0x1f ", nil, "31", nil},
+		{"1e30", nil, "1000000000000000000000000000000", nil},
+		{"1.5", nil, "", ErrConvert},
+		{json.Number("98765432109876543210"), nil, "98765432109876543210", nil},
+		{1e20, nil, "100000000000000000000", nil},
+		{2.5, nil, "", ErrConvert},
+		{2.5, []Option{Rounding(RoundNearest)}, "3", nil},
+		{-2.5, []Option{Rounding(RoundNearest)}, "-3", nil},
+		{-2.5, []Option{Rounding(RoundFloor)}, "-3", nil},
+		{2.5, []Option{Rounding(RoundCeil)}, "3", nil},
+		{-2.5, []Option{Rounding(RoundTruncate)}, "-2", nil},
+		{math.Inf(1), nil, "", ErrConvert},
+		{math.NaN(), nil, "", ErrConvert},
+		{complex(3, 0), nil, "3", nil},
+		{complex(3, 1), nil, "", ErrConvert},
+		{big.NewFloat(1e10), nil, "10000000000", nil},
+		{big.NewRat(10, 2), nil, "5", nil},
+		{big.NewRat(1, 2), nil, "", ErrConvert},
+		{true, nil, "", ErrConvert},
+		{nil, nil, "", ErrConvert},
+	}
+	for _, v := range testData {
+		nv := Of(v.value, v.options...).BigInt()
+		if (v.err == nil && nv.V().String() != v.expected) || !errors.Is(nv.Err(), v.err) {
+			t.Errorf("Of(%v).BigInt() failed, expected (expected == actual) %v == %v, error %v == %v",
+				v.value, v.expected, nv.V(), v.err, nv.Err(),
+			)
+		}
+	}
+	if nv := Of("invalid").BigInt(big.NewInt(7)); nv.V().Int64() != 7 || !errors.Is(nv.Err(), ErrConvert) {
+		t.Errorf("Of(invalid).BigInt() failed, expected default value 7 instead of %v, error %v", nv.V(), nv.Err())
+	}
+	if nv := Of("invalid").BigInt(big.NewInt(7), big.NewInt(8)); !errors.Is(nv.Err(), ErrDefaultValue) {
+		t.Errorf("Of(invalid).BigInt() failed, expected error %v instead of %v", ErrDefaultValue, nv.Err())
+	}
+	source := big.NewInt(1)
+	nv := Of(source).BigInt()
+	source.SetInt64(2)
+	if nv.V().Int64() != 1 {
+		t.Errorf("Of(big.Int).BigInt() failed, value must be copied")
+	}
+}
+
+func TestBigFloat(t *testing.T) {
+	testData := []struct {
+		value    interface{}
+		prec     uint
+		expected string
+		exPrec   uint
+		err      error
+	}{
+		{1.5, 0, "1.5", 53, nil},
+		{42, 0, "42", 64, nil},
+		{uint8(42), 10, "42", 10, nil},
+		{"0.1", 0, "0.1", 64, nil},
+		{"3.14159265358979323846264338327950288", 0, "3.14159265358979323846264338327950288", 121, nil},
+		{json.Number("1e-400"), 0, "1e-400", 64, nil},
+		{"0.1", 8, "0.1", 8, nil},
+		{"invalid", 0, "", 0, ErrConvert},
+		{big.NewInt(5), 0, "5", 64, nil},
+		{big.NewRat(1, 4), 0, "0.25", 64, nil},
+		{new(big.Float).SetPrec(200).SetInt64(3), 0, "3", 200, nil},
+		{math.Inf(-1), 0, "-Inf", 53, nil},
+		{math.NaN(), 0, "", 0, ErrConvert},
+		{complex(1, 1), 0, "", 0, ErrConvert},
+		{false, 0, "", 0, ErrConvert},
+	}
+	for _, v := range testData {
+		nv := Of(v.value).BigFloat(v.prec)
+		if (v.err == nil && (nv.V().Text('g', -1) != v.expected || nv.V().Prec() != v.exPrec)) || !errors.Is(nv.Err(), v.err) {
+			t.Errorf("Of(%v).BigFloat(%d) failed, expected (expected == actual) %v == %v, prec %d == %d, error %v == %v",
+				v.value, v.prec, v.expected, nv.V().Text('g', -1), v.exPrec, nv.V().Prec(), v.err, nv.Err(),
+			)
+		}
+	}
+}
+
+func TestBigRat(t *testing.T) {
+	testData := []struct {
+		value    interface{}
+		expected string
+		err      error
+	}{
+		{"1/3", "1/3", nil},
+		{"0.125", "1/8", nil},
+		{0.5, "1/2", nil},
+		{-4, "-4", nil},
+		{big.NewRat(2, 6), "1/3", nil},
+		{big.NewFloat(0.75), "3/4", nil},
+		{big.NewInt(9), "9", nil},
+		{json.Number("2.5"), "5/2", nil},
+		{math.Inf(1), "", ErrConvert},
+		{"1/0", "", ErrConvert},
+		{"invalid", "", ErrConvert},
+	}
+	for _, v := range testData {
+		nv := Of(v.value).BigRat()
+		if (v.err == nil && nv.V().RatString() != v.expected) || !errors.Is(nv.Err(), v.err) {
+			t.Errorf("Of(%v).BigRat() failed, expected (expected == actual) %v == %v, error %v == %v",
+				v.value, v.expected, nv.V().RatString(), v.err, nv.Err(),
+			)
+		}
+	}
+}
@@ -3,20 +3,81 @@ package typ
 import (
 	"reflect"
 	"strings"
+	"sync"
 )
 
+// BoolVocabulary is a set of words recognized as bool values in case-insensitive mode
+type BoolVocabulary struct {
+	True, False []string
+}
+
+var (
+	boolVocabulariesMu sync.RWMutex
+	boolVocabularies   = map[string]BoolVocabulary{
+		"en": {
+			True:  []string{"true", "1", "yes", "y", "on", "t", "enabled", "enable"},
+			False: []string{"false", "0", "no", "n", "off", "f", "disabled", "disable"},
+		},
+	}
+)
+
+// DefaultBoolWords are names of vocabularies used for conversion of strings to bool if BoolWords option isn't set
+var DefaultBoolWords = []string{"en"}
+
+// RegisterBoolVocabulary register vocabulary of bool words under the given name, registered vocabulary replaces existing one.
+// Returns ErrInvalidArgument if vocabulary is empty or a word is both true and false
+func RegisterBoolVocabulary(name string, vocabulary BoolVocabulary) error {
+	if name == "" || len(vocabulary.True) == 0 || len(vocabulary.False) == 0 {
+		return ErrInvalidArgument
+	}
+	for _, word := range vocabulary.True {
+		if _, ok := boolWord(word, []BoolVocabulary{{False: vocabulary.False}}); ok {
+			return ErrInvalidArgument
+		}
+	}
+	boolVocabulariesMu.Lock()
+	defer boolVocabulariesMu.Unlock()
+	boolVocabularies[name] = vocabulary
+	return nil
+}
+
+// BoolWords set names of vocabularies for conversion of strings to bool by BoolHumanize & strict Bool.
+// Returns ErrInvalidArgument if vocabulary isn't registered
+func BoolWords(names ...string) Option {
+	return func(t *opts) error {
+		vocabularies, ok := lookupBoolVocabularies(names)
+		if !ok {
+			return ErrInvalidArgument
+		}
+		t.boolWords = vocabularies
+		return nil
+	}
+}
+
+// StrictBool set strict mode of Bool conversion for strings, only words of vocabularies are accepted
+// instead of true for any non-empty string
+func StrictBool() Option {
+	return func(t *opts) error {
+		t.strictBool = true
+		return nil
+	}
+}
+
 // Bool convert interface value to bool.
-// Returns true for any non-zero values
+// Returns true for any non-zero values, strings are converted by vocabularies if StrictBool option is set
 func (t *Type) Bool() BoolAccessor {
 	nv := &NullBool{}
 	if nv.Error = t.err; t.err != nil {
 		return nv
 	}
+	if t.opts.strictBool && t.IsString(true) {
+		return t.wordBool()
+	}
 	return t.toBool(false)
 }
 
 // BoolHumanize convert interface value to bool.
-// Returns false for string 'false' in case-insensitive mode or string equals '0', for other types
+// Strings are converted by vocabularies in case-insensitive mode, e.g. "yes", "off" or "0", for other types
 // returns true only for positive values
 func (t *Type) BoolHumanize() BoolAccessor {
 	nv := &NullBool{}
@@ -25,13 +86,7 @@ func (t *Type) BoolHumanize() BoolAccessor {
 	}
 	switch {
 	case t.IsString(true):
-		bf := strings.EqualFold("false", t.rv.String()) || t.rv.String() == "0"
-		bt := strings.EqualFold("true", t.rv.String()) || t.rv.String() == "1"
-		if !bf && !bt {
-			nv.Error = ErrUnexpectedValue
-		}
-		nv.P = &bt
-		return nv
+		return t.wordBool()
 	case t.IsBool(true):
 		v := t.rv.Bool()
 		nv.P = &v
@@ -100,16 +155,77 @@ end:
 	return nv
 }
 
-// StringBoolHumanize convert value from string to bool.
-// Returns false for string 'false' in case-insensitive mode or string equals '0'
-func StringBoolHumanize(from string) BoolAccessor {
+// StringBoolHumanize convert value from string to bool by vocabularies in case-insensitive mode,
+// DefaultBoolWords are used if BoolWords option isn't set
+func StringBoolHumanize(from string, options ...Option) BoolAccessor {
 	nv := &NullBool{}
-	bf := strings.EqualFold("false", from) || from == "0"
-	bt := strings.EqualFold("true", from) || from == "1"
-	if !bf && !bt {
+	t := Of(from, options...)
+	if nv.Error = t.err; t.err != nil {
+		return nv
+	}
+	v, ok := boolWord(from, t.boolVocabularies())
+	if !ok {
 		nv.Error = ErrUnexpectedValue
 		return nv
 	}
-	nv.P = &bt
+	nv.P = &v
 	return nv
 }
+
+// Convert string value to bool by vocabularies.
+// Returns ErrUnexpectedValue if string isn't a word of vocabularies
+func (t *Type) wordBool() BoolAccessor {
+	nv := &NullBool{}
+	v, ok := boolWord(t.rv.String(), t.boolVocabularies())
+	if !ok {
+		nv.Error = ErrUnexpectedValue
+	}
+	nv.P = &v
+	return nv
+}
+
+// Returns vocabularies of BoolWords option or DefaultBoolWords
+func (t *Type) boolVocabularies() []BoolVocabulary {
+	if t.opts.boolWords != nil {
+		return t.opts.boolWords
+	}
+	vocabularies, _ := lookupBoolVocabularies(DefaultBoolWords)
+	return vocabularies
+}
+
+// Returns registered vocabularies by names, false is returned if any of them isn't registered
+func lookupBoolVocabularies(names []string) ([]BoolVocabulary, bool) {
+	if len(names) == 0 {
+		return nil, false
+	}
+	boolVocabulariesMu.RLock()
+	defer boolVocabulariesMu.RUnlock()
+	vocabularies := make([]BoolVocabulary, 0, len(names))
+	for _, name := range names {
+		vocabulary, ok := boolVocabularies[name]
+		if !ok {
+			return nil, false
+		}
+		vocabularies = append(vocabularies, vocabulary)
+	}
+	return vocabularies, true
+}
+
+// Returns bool value of word in vocabularies, surrounding white space is ignored.
+// Returns false if word isn't found
+func boolWord(word string, vocabularies []BoolVocabulary) (bool, bool) {
+	word = strings.TrimSpace(word)
+	for _, vocabulary := range vocabularies {
+		for _, w := range vocabulary.True {
+			if strings.EqualFold(w, word) {
+				return true, true
+			}
+		}
+		for _, w := range vocabulary.False {
+			if strings.EqualFold(w, word) {
+				return false, true
+			}
+		}
+	}
+	return false, false
+}
@@ -2,6 +2,7 @@ package typ
 
 import (
 	"database/sql/driver"
+	"errors"
 	"reflect"
 	"strings"
 	"testing"
@@ -202,6 +203,66 @@ func TestBoolHumanize(t *testing.T) {
 	}
 }
 
+func TestBoolWords(t *testing.T) {
+	if err := RegisterBoolVocabulary("de", BoolVocabulary{True: []string{"ja", "an"}, False: []string{"nein", "aus"}}); err != nil {
+		t.Fatalf("RegisterBoolVocabulary() failed, error %v", err)
+	}
+	testData := []struct {
+		value    string
+		options  []Option
+		expected bool
+		err      error
+	}{
+		{"yes", nil, true, nil},
+		{"Y", nil, true, nil},
+		{" ON ", nil, true, nil},
+		{"Enabled", nil, true, nil},
+		{"off", nil, false, nil},
+		{"No", nil, false, nil},
+		{"FALSE", nil, false, nil},
+		{"0", nil, false, nil},
+		{"maybe", nil, false, ErrUnexpectedValue},
+		{"", nil, false, ErrUnexpectedValue},
+		{"Ja", []Option{BoolWords("de")}, true, nil},
+		{"aus", []Option{BoolWords("de")}, false, nil},
+		{"yes", []Option{BoolWords("de")}, false, ErrUnexpectedValue},
+		{"yes", []Option{BoolWords("de", "en")}, true, nil},
+		{"yes", []Option{BoolWords("unknown")}, false, ErrInvalidArgument},
+		{"yes", []Option{BoolWords()}, false, ErrInvalidArgument},
+	}
+	for _, v := range testData {
+		nv := Of(v.value, v.options...).BoolHumanize()
+		if nv.V() != v.expected || !errors.Is(nv.Err(), v.err) {
+			t.Errorf("Of(%q).BoolHumanize() failed, expected (expected == actual) %v == %v, error %v == %v",
+				v.value, v.expected, nv.V(), v.err, nv.Err(),
+			)
+		}
+		nv = StringBoolHumanize(v.value, v.options...)
+		if nv.V() != v.expected || !errors.Is(nv.Err(), v.err) {
+			t.Errorf("StringBoolHumanize(%q) failed, expected (expected == actual) %v == %v, error %v == %v",
+				v.value, v.expected, nv.V(), v.err, nv.Err(),
+			)
+		}
+		nv = Of(v.value, append([]Option{StrictBool()}, v.options...)...).Bool()
+		if nv.V() != v.expected || !errors.Is(nv.Err(), v.err) {
+			t.Errorf("Of(%q, StrictBool()).Bool() failed, expected (expected == actual) %v == %v, error %v == %v",
+				v.value, v.expected, nv.V(), v.err, nv.Err(),
+			)
+		}
+	}
+	if nv := Of("false").Bool(); !nv.V() || nv.Err() != nil {
+		t.Errorf("Of(false).Bool() failed, expected true for non-empty string instead of %v, error %v", nv.V(), nv.Err())
+	}
+	if nv := Of(2, StrictBool()).Bool(); !nv.V() || nv.Err() != nil {
+		t.Errorf("Of(2, StrictBool()).Bool() failed, expected true instead of %v, error %v", nv.V(), nv.Err())
+	}
+	for _, v := range []BoolVocabulary{{}, {True: []string{"si"}}, {True: []string{"x"}, False: []string{"X"}}} {
+		if err := RegisterBoolVocabulary("invalid", v); !errors.Is(err, ErrInvalidArgument) {
+			t.Errorf("RegisterBoolVocabulary(%v) failed, expected error %v instead of %v", v, ErrInvalidArgument, err)
+		}
+	}
+}
+
 // BenchmarkOfBool-8   	 10000000	       124 ns/op
 func BenchmarkOfBool(b *testing.B) {
 	v := map[interface{}]interface{}{}
@@ -0,0 +1,172 @@
+package typ
+
+import (
+	"math/big"
+	"math/bits"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ByteUnits is a system of units for humanized byte sizes
+type ByteUnits int
+
+const (
+	// BytesIEC are binary units with powers of 1024: KiB, MiB, GiB, ...
+	BytesIEC ByteUnits = iota
+	// BytesSI are decimal units with powers of 1000: kB, MB, GB, ...
+	BytesSI
+)
+
+var (
+	byteUnitNames = map[ByteUnits][]string{
+		BytesIEC: {"B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB"},
+		BytesSI:  {"B", "kB", "MB", "GB", "TB", "PB", "EB"},
+	}
+	byteUnitBases = map[ByteUnits]uint64{BytesIEC: 1024, BytesSI: 1000}
+	// byteUnitSizes are multipliers of lower-cased unit suffixes of byte sizes
+	byteUnitSizes = map[string]uint64{
+		"": 1, "b": 1,
+		"k": 1e3, "kb": 1e3, "m": 1e6, "mb": 1e6, "g": 1e9, "gb": 1e9,
+		"t": 1e12, "tb": 1e12, "p": 1e15, "pb": 1e15, "e": 1e18, "eb": 1e18,
+		"ki": 1 << 10, "kib": 1 << 10, "mi": 1 << 20, "mib": 1 << 20, "gi": 1 << 30, "gib": 1 << 30,
+		"ti": 1 << 40, "tib": 1 << 40, "pi": 1 << 50, "pib": 1 << 50, "ei": 1 << 60, "eib": 1 << 60,
+	}
+)
+
+type bytesOpts struct {
+	units     ByteUnits
+	precision int
+}
+
+// BytesOption is interface function used as argument value for configuration of humanized byte sizes
+type BytesOption func(*bytesOpts) error
+
+// BytesUnitSystem set system of units for humanized byte sizes, BytesIEC is used by default
+func BytesUnitSystem(units ByteUnits) BytesOption {
+	return func(t *bytesOpts) error {
+		if _, ok := byteUnitNames[units]; !ok {
+			return ErrInvalidArgument
+		}
+		t.units = units
+		return nil
+	}
+}
+
+// BytesPrecision set maximum count of fractional digits for humanized byte sizes, trailing zeros are removed.
+// The special precision -1 uses the smallest number of digits necessary to represent the value exactly
+func BytesPrecision(precision int) BytesOption {
+	return func(t *bytesOpts) error {
+		if precision < -1 {
+			return ErrInvalidArgument
+		}
+		t.precision = precision
+		return nil
+	}
+}
+
+// Bytes convert interface value to count of bytes.
+// Strings are parsed as sizes with SI ("1.5GB", "10k") or IEC ("512MiB") units in case-insensitive mode,
+// fractional sizes must be integral in bytes unless Rounding option is set. Other types are converted as Uint64.
+// Returns value if type can safely converted, otherwise error & default value in result values
+func (t *Type) Bytes(defaultValue ...uint64) Uint64Accessor {
+	nv := &NullUint64{}
+	if nv.Error = t.err; t.err != nil {
+		return nv
+	}
+	if !t.IsString(true) {
+		return t.Uint64(defaultValue...)
+	}
+	v, lost, err := parseBytes(t.rv.String(), t.opts.rounding)
+	if err != nil {
+		ce := t.newConversionError(reflect.Uint64, err)
+		if err == ErrConvert {
+			ce.Reason = ReasonPrecision
+		}
+		nv.Error = ce
+	}
+	nv.Lost = lost
+	if defaultUint64(nv, defaultValue...) {
+		return nv
+	}
+	nv.P = &v
+	return nv
+}
+
+// StringBytes convert value from string with SI or IEC units to count of bytes.
+// Returns value if type can safely converted, otherwise error & default value in result values
+func StringBytes(from string, defaultValue ...uint64) Uint64Accessor {
+	return Of(from).Bytes(defaultValue...)
+}
+
+// HumanizeBytes convert count of bytes to string with the largest unit of size, e.g. "1.5 KiB" or "512 B"
+func HumanizeBytes(from uint64, options ...BytesOption) StringAccessor {
+	nv := &NullString{}
+	opts := bytesOpts{units: BytesIEC, precision: 1}
+	for _, v := range options {
+		if optErr := v(&opts); optErr != nil {
+			nv.Error = optErr
+			return nv
+		}
+	}
+	names, base := byteUnitNames[opts.units], byteUnitBases[opts.units]
+	unit, div := 0, uint64(1)
+	for unit < len(names)-1 && from/div >= base {
+		unit, div = unit+1, div*base
+	}
+	value := strconv.FormatFloat(float64(from)/float64(div), 'f', opts.precision, 64)
+	if f, _ := strconv.ParseFloat(value, 64); f >= float64(base) && unit < len(names)-1 {
+		unit, div = unit+1, div*base
+		value = strconv.FormatFloat(float64(from)/float64(div), 'f', opts.precision, 64)
+	}
+	if strings.Contains(value, ".") {
+		value = strings.TrimRight(strings.TrimRight(value, "0"), ".")
+	}
+	v := value + " " + names[unit]
+	nv.P = &v
+	return nv
+}
+
+// Parse byte size with unit, value is rounded by rounding mode if it isn't integral in bytes.
+// Returns true if value was rounded, ErrConvert is returned if value isn't integral in bytes and it can't be rounded
+func parseBytes(from string, mode RoundingMode) (uint64, bool, error) {
+	from = strings.TrimSpace(from)
+	i := strings.LastIndexFunc(from, func(r rune) bool { return unicode.IsDigit(r) || r == '.' }) + 1
+	number, suffix := from[:i], strings.ToLower(strings.TrimSpace(from[i:]))
+	size, ok := byteUnitSizes[suffix]
+	if !ok || number == "" {
+		return 0, false, &strconv.NumError{Func: "ParseUint", Num: from, Err: strconv.ErrSyntax}
+	}
+	if isDigits(number) {
+		v, err := strconv.ParseUint(number, 10, 64)
+		if err != nil {
+			return 0, false, err
+		}
+		hi, lo := bits.Mul64(v, size)
+		if hi != 0 {
+			return 0, false, &strconv.NumError{Func: "ParseUint", Num: from, Err: strconv.ErrRange}
+		}
+		return lo, false, nil
+	}
+	d, err := ParseDecimal(number)
+	if err != nil || d.Sign() < 0 {
+		return 0, false, &strconv.NumError{Func: "ParseUint", Num: from, Err: strconv.ErrSyntax}
+	}
+	r := d.Rat()
+	r.Mul(r, new(big.Rat).SetInt(new(big.Int).SetUint64(size)))
+	if r.IsInt() {
+		if !r.Num().IsUint64() {
+			return 0, false, &strconv.NumError{Func: "ParseUint", Num: from, Err: strconv.ErrRange}
+		}
+		return r.Num().Uint64(), false, nil
+	}
+	v, ok := bigRatInt(r, mode)
+	if !ok {
+		return 0, false, ErrConvert
+	}
+	if !v.IsUint64() {
+		return 0, false, &strconv.NumError{Func: "ParseUint", Num: from, Err: strconv.ErrRange}
+	}
+	return v.Uint64(), true, nil
+}
@@ -0,0 +1,129 @@
+package typ
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBytes(t *testing.T) {
+	testData := []struct {
+		value    interface{}
+		options  []Option
+		expected uint64
+		lost     bool
+		err      error
+	}{
+		{"512MiB", nil, 512 << 20, false, nil},
+		{"1.5GB", nil, 1500000000, false, nil},
+		{"10k", nil, 10000, false, nil},
+		{"10Ki", nil, 10240, false, nil},
+		{" 1 kib ", nil, 1024, false, nil},
+		{"2 TB", nil, 2e12, false, nil},
+		{"100", nil, 100, false, nil},
+		{"100b", nil, 100, false, nil},
+		{"0.5KiB", nil, 512, false, nil},
+		{".25 MB", nil, 250000, false, nil},
+		{"16EiB", nil, 0, false, ErrConvert},
+		{"15.99EiB", nil, 0, false, ErrConvert},
+		{"18446744073709551615", nil, MaxUint64, false, nil},
+		{"18446744073709551616", nil, 0, false, ErrConvert},
+		{"0.1KiB", nil, 0, false, ErrConvert},
+		{"0.1KiB", []Option{Rounding(RoundNearest)}, 102, true, nil},
+		{"1.5", nil, 0, false, ErrConvert},
+		{"1.5", []Option{Rounding(RoundCeil)}, 2, true, nil},
+		{"1.0000000000000000001EB", []Option{Rounding(RoundFloor)}, 1e18, true, nil},
+		{"9007199254740993.5B", []Option{Rounding(RoundFloor)}, 9007199254740993, true, nil},
+		{"9007199254740993.5B", []Option{Rounding(RoundNearest)}, 9007199254740994, true, nil},
+		{"18446744073709551615.5B", []Option{Rounding(RoundTruncate)}, MaxUint64, true, nil},
+		{"18446744073709551615.5B", []Option{Rounding(RoundCeil)}, 0, false, ErrConvert},
+		{"-1KB", nil, 0, false, ErrConvert},
+		{"1XB", nil, 0, false, ErrConvert},
+		{"KB", nil, 0, false, ErrConvert},
+		{"", nil, 0, false, ErrConvert},
+		{"1.2.3MB", nil, 0, false, ErrConvert},
+		{uint32(4096), nil, 4096, false, nil},
+		{2048.0, nil, 2048, false, nil},
+		{true, nil, 1, false, nil},
+	}
+	for _, v := range testData {
+		nv := Of(v.value, v.options...).Bytes()
+		if nv.V() != v.expected || nv.PrecisionLost() != v.lost || !errors.Is(nv.Err(), v.err) {
+			t.Errorf("Of(%v).Bytes() failed, expected (expected == actual) %v == %v, lost %v == %v, error %v == %v",
+				v.value, v.expected, nv.V(), v.lost, nv.PrecisionLost(), v.err, nv.Err(),
+			)
+		}
+	}
+	reasonData := []struct {
+		value   string
+		options []Option
+		reason  ConversionReason
+	}{
+		{"0.1B", nil, ReasonPrecision},
+		{"1.0005kB", nil, ReasonPrecision},
+		{"0.1KiB", nil, ReasonPrecision},
+		{"16EiB", nil, ReasonOverflow},
+		{"18446744073709551616", nil, ReasonOverflow},
+		{"15.99999999999999999999EiB", []Option{Rounding(RoundCeil)}, ReasonOverflow},
+		{"1XB", nil, ReasonSyntax},
+		{"1.2.3MB", nil, ReasonSyntax},
+	}
+	var ce *ConversionError
+	for _, v := range reasonData {
+		if nv := Of(v.value, v.options...).Bytes(); !errors.As(nv.Err(), &ce) || ce.Reason != v.reason {
+			t.Errorf("Of(%v).Bytes() failed, expected %v reason instead of %v", v.value, v.reason, nv.Err())
+		}
+	}
+	if err := Of("0.1B").Bytes().Err(); err == nil || err.Error() != "can't convert 0.1B (string) to uint64: precision loss" {
+		t.Errorf("Of(0.1B).Bytes() failed, expected precision loss error instead of %v", err)
+	}
+	if nv := Of("invalid").Bytes(1024); nv.V() != 1024 || !errors.Is(nv.Err(), ErrConvert) {
+		t.Errorf("Of(invalid).Bytes() failed, expected default value 1024 instead of %v, error %v", nv.V(), nv.Err())
+	}
+	if nv := Of(-1).Bytes(); !errors.As(nv.Err(), &ce) || ce.Reason != ReasonNegative {
+		t.Errorf("Of(-1).Bytes() failed, expected negative reason instead of %v", nv.Err())
+	}
+	if nv := StringBytes("4 GiB"); nv.V() != 4<<30 || nv.Err() != nil {
+		t.Errorf("StringBytes(4 GiB) failed, expected %v instead of %v, error %v", 4<<30, nv.V(), nv.Err())
+	}
+}
+
+func TestHumanizeBytes(t *testing.T) {
+	testData := []struct {
+		value    uint64
+		options  []BytesOption
+		expected string
+	}{
+		{0, nil, "0 B"},
+		{512, nil, "512 B"},
+		{1023, nil, "1023 B"},
+		{1024, nil, "1 KiB"},
+		{1536, nil, "1.5 KiB"},
+		{512 << 20, nil, "512 MiB"},
+		{1<<20 - 1, nil, "1 MiB"},
+		{MaxUint64, nil, "16 EiB"},
+		{1500000000, []BytesOption{BytesUnitSystem(BytesSI)}, "1.5 GB"},
+		{999999, []BytesOption{BytesUnitSystem(BytesSI)}, "1 MB"},
+		{999, []BytesOption{BytesUnitSystem(BytesSI)}, "999 B"},
+		{1234567, []BytesOption{BytesUnitSystem(BytesSI), BytesPrecision(3)}, "1.235 MB"},
+		{1234567, []BytesOption{BytesUnitSystem(BytesSI), BytesPrecision(0)}, "1 MB"},
+		{1234567, []BytesOption{BytesUnitSystem(BytesSI), BytesPrecision(-1)}, "1.234567 MB"},
+		{1100, []BytesOption{BytesPrecision(2)}, "1.07 KiB"},
+	}
+	for _, v := range testData {
+		nv := HumanizeBytes(v.value, v.options...)
+		if nv.V() != v.expected || nv.Err() != nil {
+			t.Errorf("HumanizeBytes(%v) failed, expected %q instead of %q, error %v", v.value, v.expected, nv.V(), nv.Err())
+		}
+	}
+	if nv := HumanizeBytes(1, BytesUnitSystem(ByteUnits(9))); !errors.Is(nv.Err(), ErrInvalidArgument) {
+		t.Errorf("HumanizeBytes() failed, expected error %v instead of %v", ErrInvalidArgument, nv.Err())
+	}
+	if nv := HumanizeBytes(1, BytesPrecision(-2)); !errors.Is(nv.Err(), ErrInvalidArgument) {
+		t.Errorf("HumanizeBytes() failed, expected error %v instead of %v", ErrInvalidArgument, nv.Err())
+	}
+	for _, v := range []uint64{1, 1536, 3 << 30, 512 << 20} {
+		if nv := StringBytes(HumanizeBytes(v).V()); nv.V() != v {
+			t.Errorf("StringBytes(HumanizeBytes(%v)) failed, expected %v instead of %v", v, v, nv.V())
+		}
+	}
+}
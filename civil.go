@@ -0,0 +1,208 @@
+package typ
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+const (
+	// DateLayout is a layout of Date in strings
+	DateLayout = "2006-01-02"
+	// ClockLayout is a layout of Clock in strings, fractional seconds are optional
+	ClockLayout = "15:04:05.999999999"
+	// Zero value of Date in strings
+	zeroDate = "0000-00-00"
+)
+
+var (
+	dateType  = reflect.TypeOf(Date{})
+	clockType = reflect.TypeOf(Clock{})
+)
+
+// Date represents a civil date without time and location
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// DateOf returns Date of time.Time in its location
+func DateOf(t time.Time) Date {
+	var d Date
+	d.Year, d.Month, d.Day = t.Date()
+	return d
+}
+
+// ParseDate parse date in format "2006-01-02", zero date "0000-00-00" is parsed as zero value
+func ParseDate(s string) (Date, error) {
+	if s == zeroDate {
+		return Date{}, nil
+	}
+	t, err := time.Parse(DateLayout, s)
+	if err != nil {
+		return Date{}, err
+	}
+	return DateOf(t), nil
+}
+
+// In returns time.Time of midnight at date in location
+func (d Date) In(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// IsZero determines whether a date is zero value
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+// String returns date in format "2006-01-02", zero value is returned as "0000-00-00"
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// Clock represents a civil time of day without date and location
+type Clock struct {
+	Hour       int
+	Minute     int
+	Second     int
+	Nanosecond int
+}
+
+// ClockOf returns Clock of time.Time in its location
+func ClockOf(t time.Time) Clock {
+	c := Clock{Nanosecond: t.Nanosecond()}
+	c.Hour, c.Minute, c.Second = t.Clock()
+	return c
+}
+
+// ParseClock parse time of day in format "15:04:05.999999999" or "15:04"
+func ParseClock(s string) (Clock, error) {
+	t, err := time.Parse(ClockLayout, s)
+	if err != nil {
+		var shortErr error
+		if t, shortErr = time.Parse("15:04", s); shortErr != nil {
+			return Clock{}, err
+		}
+	}
+	return ClockOf(t), nil
+}
+
+// On returns time.Time of clock at date in location
+func (c Clock) On(d Date, loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, c.Hour, c.Minute, c.Second, c.Nanosecond, loc)
+}
+
+// IsZero determines whether a clock is zero value, i.e. midnight
+func (c Clock) IsZero() bool {
+	return c == Clock{}
+}
+
+// String returns clock in format "15:04:05.999999999"
+func (c Clock) String() string {
+	return c.On(Date{1, time.January, 1}, time.UTC).Format(ClockLayout)
+}
+
+// Date convert interface value to Date.
+// Strings are parsed by DateLayout, other values are converted to time.Time by the same rules as Time, date of time is taken in its location.
+// Returns value if type can safely converted, otherwise error & default value in result values
+func (t *Type) Date(defaultValue ...Date) DateAccessor {
+	nv := &NullDate{}
+	if nv.Error = t.err; t.err != nil {
+		return nv
+	}
+	nv = t.toDate()
+	defaultDate(nv, defaultValue...)
+	return nv
+}
+
+// Convert interface value to Date.
+// Returns error if type can't safely converted
+func (t *Type) toDate() *NullDate {
+	nv := &NullDate{}
+	if t.rv.IsValid() && t.rv.Type() == dateType {
+		v := t.rv.Interface().(Date)
+		nv.P = &v
+		return nv
+	}
+	if t.IsString(true) {
+		if v, err := ParseDate(strings.TrimSpace(t.rv.String())); err == nil {
+			nv.P = &v
+			return nv
+		}
+	}
+	nt := t.toTime()
+	if nv.Error = nt.Err(); nv.Error != nil {
+		return nv
+	}
+	v := DateOf(nt.V())
+	nv.P = &v
+	return nv
+}
+
+// Clock convert interface value to Clock.
+// Strings are parsed by ClockLayout, other values are converted to time.Time by the same rules as Time,
+// clock of time is taken in its location.
+// Returns value if type can safely converted, otherwise error & default value in result values
+func (t *Type) Clock(defaultValue ...Clock) ClockAccessor {
+	nv := &NullClock{}
+	if nv.Error = t.err; t.err != nil {
+		return nv
+	}
+	nv = t.toClock()
+	defaultClock(nv, defaultValue...)
+	return nv
+}
+
+// Convert interface value to Clock.
+// Returns error if type can't safely converted
+func (t *Type) toClock() *NullClock {
+	nv := &NullClock{}
+	if t.rv.IsValid() && t.rv.Type() == clockType {
+		v := t.rv.Interface().(Clock)
+		nv.P = &v
+		return nv
+	}
+	if t.IsString(true) {
+		if v, err := ParseClock(strings.TrimSpace(t.rv.String())); err == nil {
+			nv.P = &v
+			return nv
+		}
+	}
+	nt := t.toTime()
+	if nv.Error = nt.Err(); nv.Error != nil {
+		return nv
+	}
+	v := ClockOf(nt.V())
+	nv.P = &v
+	return nv
+}
+
+// Set default date value if date isn't valid
+func defaultDate(nv *NullDate, defaultValue ...Date) bool {
+	if len(defaultValue) > 1 {
+		nv.Error = ErrDefaultValue
+		return true
+	}
+	if !nv.Valid() && len(defaultValue) > 0 {
+		v := defaultValue[0]
+		nv.P = &v
+		return true
+	}
+	return false
+}
+
+// Set default clock value if clock isn't valid
+func defaultClock(nv *NullClock, defaultValue ...Clock) bool {
+	if len(defaultValue) > 1 {
+		nv.Error = ErrDefaultValue
+		return true
+	}
+	if !nv.Valid() && len(defaultValue) > 0 {
+		v := defaultValue[0]
+		nv.P = &v
+		return true
+	}
+	return false
+}
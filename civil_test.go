@@ -0,0 +1,91 @@
+package typ
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDate(t *testing.T) {
+	est := time.FixedZone("EST", -5*60*60)
+	testData := []struct {
+		value    interface{}
+		options  []Option
+		expected Date
+		err      error
+	}{
+		{"2023-11-14", nil, Date{2023, time.November, 14}, nil},
+		{" 2023-11-14 ", nil, Date{2023, time.November, 14}, nil},
+		{"0000-00-00", nil, Date{}, nil},
+		{"2023-11-14T23:30:00-05:00", nil, Date{2023, time.November, 14}, nil},
+		{"2023-11-14 23:30:00", nil, Date{2023, time.November, 14}, nil},
+		{time.Date(2023, 11, 14, 23, 30, 0, 0, est), nil, Date{2023, time.November, 14}, nil},
+		{time.Date(2023, 11, 14, 23, 30, 0, 0, est), []Option{Location(time.UTC)}, Date{2023, time.November, 15}, nil},
+		{1700000000, nil, Date{2023, time.November, 14}, nil},
+		{Date{2020, time.February, 29}, nil, Date{2020, time.February, 29}, nil},
+		{"2023-02-30", nil, Date{}, ErrConvert},
+		{"invalid", nil, Date{}, ErrConvert},
+		{true, nil, Date{}, ErrConvert},
+		{nil, nil, Date{}, ErrConvert},
+	}
+	for _, v := range testData {
+		nv := Of(v.value, v.options...).Date()
+		if nv.V() != v.expected || !errors.Is(nv.Err(), v.err) {
+			t.Errorf("Of(%v).Date() failed, expected (expected == actual) %v == %v, error %v == %v",
+				v.value, v.expected, nv.V(), v.err, nv.Err(),
+			)
+		}
+	}
+	if nv := Of("invalid").Date(Date{2000, time.January, 1}); nv.V() != (Date{2000, time.January, 1}) || !errors.Is(nv.Err(), ErrConvert) {
+		t.Errorf("Of(invalid).Date() failed, expected default value instead of %v, error %v", nv.V(), nv.Err())
+	}
+	if nv := Of("invalid").Date(Date{}, Date{}); !errors.Is(nv.Err(), ErrDefaultValue) {
+		t.Errorf("Of(invalid).Date() failed, expected error %v instead of %v", ErrDefaultValue, nv.Err())
+	}
+	d := Date{2023, time.November, 14}
+	if nv := Of(d, Location(est)).Time(); !nv.V().Equal(time.Date(2023, 11, 14, 0, 0, 0, 0, est)) {
+		t.Errorf("Of(%v).Time() failed, expected midnight in location instead of %v", d, nv.V())
+	}
+	if nv := Of(d).Time(); !nv.V().Equal(time.Date(2023, 11, 14, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Of(%v).Time() failed, expected midnight in UTC instead of %v", d, nv.V())
+	}
+	if d.String() != "2023-11-14" || (Date{}).String() != "0000-00-00" || !(Date{}).IsZero() || d.IsZero() {
+		t.Errorf("Date.String() failed, unexpected %v or %v", d, Date{})
+	}
+}
+
+func TestClock(t *testing.T) {
+	testData := []struct {
+		value    interface{}
+		expected Clock
+		err      error
+	}{
+		{"22:13:20", Clock{22, 13, 20, 0}, nil},
+		{"22:13:20.000123", Clock{22, 13, 20, 123000}, nil},
+		{"22:13", Clock{22, 13, 0, 0}, nil},
+		{"2023-11-14T22:13:20.5Z", Clock{22, 13, 20, 500000000}, nil},
+		{time.Date(2023, 11, 14, 22, 13, 20, 0, time.UTC), Clock{22, 13, 20, 0}, nil},
+		{Clock{1, 2, 3, 4}, Clock{1, 2, 3, 4}, nil},
+		{"25:00:00", Clock{}, ErrConvert},
+		{"838:59:59", Clock{}, ErrConvert},
+		{true, Clock{}, ErrConvert},
+	}
+	for _, v := range testData {
+		nv := Of(v.value).Clock()
+		if nv.V() != v.expected || !errors.Is(nv.Err(), v.err) {
+			t.Errorf("Of(%v).Clock() failed, expected (expected == actual) %v == %v, error %v == %v",
+				v.value, v.expected, nv.V(), v.err, nv.Err(),
+			)
+		}
+	}
+	if nv := Of("invalid").Clock(Clock{Hour: 12}); nv.V() != (Clock{Hour: 12}) || !errors.Is(nv.Err(), ErrConvert) {
+		t.Errorf("Of(invalid).Clock() failed, expected default value instead of %v, error %v", nv.V(), nv.Err())
+	}
+	c := Clock{22, 13, 20, 500000000}
+	if c.String() != "22:13:20.5" || (Clock{}).String() != "00:00:00" || !(Clock{}).IsZero() {
+		t.Errorf("Clock.String() failed, unexpected %v or %v", c, Clock{})
+	}
+	if v := c.On(Date{2023, time.November, 14}, time.UTC); !v.Equal(time.Date(2023, 11, 14, 22, 13, 20, 500000000, time.UTC)) {
+		t.Errorf("Clock.On() failed, unexpected %v", v)
+	}
+}
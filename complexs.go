@@ -43,9 +43,12 @@ func (t *Type) Complex(defaultValue ...complex128) ComplexAccessor {
 func (t *Type) toComplex(typeTo reflect.Kind) ComplexAccessor {
 	nv := &NullComplex{}
 	if !t.rv.IsValid() || !isComplex(typeTo) {
-		nv.Error = ErrConvert
+		nv.Error = t.newConversionError(typeTo, nil)
 		return nv
 	}
+	if nt, ok := t.fromNumber(); ok {
+		return nt.toComplex(typeTo)
+	}
 	switch {
 	case t.IsString(true):
 		matches := regexpComplex.FindStringSubmatch(t.rv.String())
@@ -60,13 +63,13 @@ func (t *Type) toComplex(typeTo reflect.Kind) ComplexAccessor {
 			}
 			return nv
 		}
-		nv.Error = ErrConvert
+		nv.Error = t.newConversionError(typeTo, nil)
 		return nv
 	case t.IsComplex(true):
 		v := t.rv.Complex()
 		nv.P = &v
 		if !isSafeComplex(t.rv.Complex(), bitSizeMap[typeTo]) {
-			nv.Error = ErrConvert
+			nv.Error = t.newConversionError(typeTo, nil)
 		}
 		return nv
 	case t.IsBool(true):
@@ -90,7 +93,7 @@ func (t *Type) toComplex(typeTo reflect.Kind) ComplexAccessor {
 func IntComplex64(from int64, defaultValue ...complex64) Complex64Accessor {
 	nv := &NullComplex64{}
 	if safe := isSafeIntToFloat(from, 32); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Complex64, nil)
 		if defaultComplex64(nv, defaultValue...) {
 			return nv
 		}
@@ -105,7 +108,7 @@ func IntComplex64(from int64, defaultValue ...complex64) Complex64Accessor {
 func IntComplex(from int64, defaultValue ...complex128) ComplexAccessor {
 	nv := &NullComplex{}
 	if safe := isSafeIntToFloat(from, 64); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Complex128, nil)
 		if defaultComplex(nv, defaultValue...) {
 			return nv
 		}
@@ -120,7 +123,7 @@ func IntComplex(from int64, defaultValue ...complex128) ComplexAccessor {
 func UintComplex64(from uint64, defaultValue ...complex64) Complex64Accessor {
 	nv := &NullComplex64{}
 	if safe := isSafeUintToFloat(from, 32); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Complex64, nil)
 		if defaultComplex64(nv, defaultValue...) {
 			return nv
 		}
@@ -135,7 +138,7 @@ func UintComplex64(from uint64, defaultValue ...complex64) Complex64Accessor {
 func UintComplex(from uint64, defaultValue ...complex128) ComplexAccessor {
 	nv := &NullComplex{}
 	if safe := isSafeUintToFloat(from, 64); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Complex128, nil)
 		if defaultComplex(nv, defaultValue...) {
 			return nv
 		}
@@ -156,7 +159,7 @@ func Float32Complex64(from float32, defaultValue ...complex64) Complex64Accessor
 func FloatComplex64(from float64, defaultValue ...complex64) Complex64Accessor {
 	nv := &NullComplex64{}
 	if safe := isSafeFloat(from, 32); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Complex64, nil)
 		if defaultComplex64(nv, defaultValue...) {
 			return nv
 		}
@@ -171,7 +174,7 @@ func FloatComplex64(from float64, defaultValue ...complex64) Complex64Accessor {
 func Complex64(from complex128, defaultValue ...complex64) Complex64Accessor {
 	nv := &NullComplex64{}
 	if safe := isSafeComplex(from, 32); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Complex64, nil)
 		if defaultComplex64(nv, defaultValue...) {
 			return nv
 		}
@@ -187,7 +190,7 @@ func StringComplex64(from string, defaultValue ...complex64) Complex64Accessor {
 	nv := &NullComplex64{}
 	matches := regexpComplex.FindStringSubmatch(from)
 	if len(matches) < 3 {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Complex64, nil)
 		defaultComplex64(nv, defaultValue...)
 		return nv
 	}
@@ -210,7 +213,7 @@ func StringComplex(from string, defaultValue ...complex128) ComplexAccessor {
 	nv := &NullComplex{}
 	matches := regexpComplex.FindStringSubmatch(from)
 	if len(matches) < 3 {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Complex128, nil)
 		defaultComplex(nv, defaultValue...)
 		return nv
 	}
@@ -1,54 +1,73 @@
 package typ
 
 import (
+	"fmt"
 	"reflect"
+	"strconv"
+	"strings"
 )
 
-// Get retrieve value from composite type, argument values used as index keys
-func (t *Type) Get(argIndexes ...interface{}) (typ *Type) {
+// Get retrieve value from composite type, argument values used as index keys.
+// Struct fields are retrieved by string keys matching either the field name or its `json` tag name,
+// fields of embedded structs are promoted and pointers are followed
+func (t *Type) Get(argIndexes ...interface{}) *Type {
+	return t.get(argIndexes, false)
+}
+
+// Path retrieve value from composite type by path in dot notation, e.g. "users.0.address.city".
+// Path segments are converted to the key type expected by each container
+func (t *Type) Path(path string) *Type {
+	keys, err := parseDotPath(path)
+	if err != nil {
+		return NewType(nil, err)
+	}
+	return t.get(keys, true)
+}
+
+// Pointer retrieve value from composite type by JSON Pointer (RFC 6901), e.g. "/users/0/address/city".
+// Pointer segments are converted to the key type expected by each container, empty pointer refers to the whole value
+func (t *Type) Pointer(pointer string) *Type {
+	keys, err := parsePointer(pointer)
+	if err != nil {
+		return NewType(nil, err)
+	}
+	if len(keys) == 0 {
+		return NewType(t, nil)
+	}
+	return t.get(keys, true)
+}
+
+// Retrieve value from composite type by index keys.
+// If coerce is true, string keys are converted to the key type expected by each container
+func (t *Type) get(keys []interface{}, coerce bool) (typ *Type) {
 	if !t.rv.IsValid() {
 		return NewType(nil, ErrInvalidArgument)
 	}
 	var (
 		p   = t.rv
-		cnt = len(argIndexes)
-		i   int
+		cnt = len(keys)
+		err error
 	)
 	defer func() {
 		if r := recover(); r != nil {
 			typ = NewType(nil, ErrInvalidArgument)
 		}
 	}()
-	for ; i < cnt; i++ {
-		switch p.Kind() {
-		case reflect.Slice, reflect.Array:
-			index, ok := argIndexes[i].(int)
-			if !ok {
-				return NewType(nil, ErrInvalidArgument)
-			}
-			if index < 0 || index > p.Len()-1 {
-				return NewType(nil, ErrOutOfRange)
-			}
-			if p = p.Index(index); p.Interface() == nil {
-				return NewType(nil, ErrOutOfBounds)
-			}
-			if p.Kind() == reflect.Interface {
-				p = p.Elem()
-			}
-		case reflect.Map:
-			if p = p.MapIndex(reflect.ValueOf(argIndexes[i])); !p.IsValid() {
-				return NewType(nil, ErrOutOfBounds)
+	for i := 0; i < cnt; i++ {
+		key := keys[i]
+		if coerce {
+			if key, err = coerceKey(p, key); err != nil {
+				return NewType(nil, err)
 			}
-			if p.Kind() == reflect.Interface {
-				p = p.Elem()
-			}
-		default:
-			return NewType(nil, ErrUnexpectedValue)
+		}
+		if p, err = getIndex(p, key); err != nil {
+			return NewType(nil, err)
 		}
 		if i == cnt-1 {
 			typed := &Type{
 				rv:   reflect.ValueOf(p.Interface()),
 				opts: t.opts,
+				path: keys,
 			}
 			typed.kind = typed.rv.Kind()
 			return typed
@@ -56,3 +75,281 @@ func (t *Type) Get(argIndexes ...interface{}) (typ *Type) {
 	}
 	return NewType(nil, ErrInvalidArgument)
 }
+
+// Path is compiled sequence of index keys used to retrieve values from composite types.
+// Path is immutable and safe for concurrent use
+type Path struct {
+	keys []interface{}
+	err  error
+}
+
+// CompilePath returns Path from index keys, keys are validated once and reused on every retrieve.
+// Valid keys are int for arrays and slices, string for structs and any comparable value for maps
+func CompilePath(keys ...interface{}) *Path {
+	if len(keys) == 0 {
+		return &Path{err: ErrInvalidArgument}
+	}
+	for _, v := range keys {
+		if v == nil || !reflect.TypeOf(v).Comparable() {
+			return &Path{err: ErrInvalidArgument}
+		}
+	}
+	return &Path{keys: append([]interface{}{}, keys...)}
+}
+
+// Keys returns copy of index keys
+func (p *Path) Keys() []interface{} {
+	return append([]interface{}{}, p.keys...)
+}
+
+// Err returns underlying error.
+func (p *Path) Err() error {
+	return p.err
+}
+
+// From retrieve value from composite type by path, same as Of(value, options...).Get(keys...).
+// If value is *Type, options are applied over its options
+func (p *Path) From(value interface{}, options ...Option) *Type {
+	if p.err != nil {
+		return NewType(nil, p.err)
+	}
+	if t, ok := value.(*Type); ok {
+		if t.err != nil {
+			return NewType(nil, t.err)
+		}
+		typed := t.get(p.keys, false)
+		if typed.err == nil {
+			typed.setOptions(options...)
+		}
+		return typed
+	}
+	var (
+		rv  = indirectValue(reflect.ValueOf(value))
+		err error
+	)
+	if !rv.IsValid() {
+		return NewType(nil, ErrInvalidArgument)
+	}
+	for _, key := range p.keys {
+		if rv, err = getIndex(rv, key); err != nil {
+			return NewType(nil, err)
+		}
+	}
+	typed := &Type{rv: reflect.ValueOf(rv.Interface()), path: p.keys}
+	typed.kind = typed.rv.Kind()
+	typed.setOptions(options...)
+	return typed
+}
+
+// Retrieve value from composite value by index key
+func getIndex(p reflect.Value, key interface{}) (reflect.Value, error) {
+	p = indirectComposite(p)
+	if !p.IsValid() {
+		return p, ErrOutOfBounds
+	}
+	switch p.Kind() {
+	case reflect.Slice, reflect.Array:
+		index, ok := key.(int)
+		if !ok {
+			return p, ErrInvalidArgument
+		}
+		if index < 0 || index > p.Len()-1 {
+			return p, ErrOutOfRange
+		}
+		if p = p.Index(index); p.Kind() == reflect.Interface && p.IsNil() {
+			return p, ErrOutOfBounds
+		}
+	case reflect.Map:
+		rk := reflect.ValueOf(key)
+		if !rk.IsValid() || !rk.Type().AssignableTo(p.Type().Key()) {
+			return p, ErrInvalidArgument
+		}
+		if p = p.MapIndex(rk); !p.IsValid() {
+			return p, ErrOutOfBounds
+		}
+	case reflect.Struct:
+		name, ok := key.(string)
+		if !ok {
+			return p, ErrUnexpectedValue
+		}
+		var err error
+		if p, err = structField(p, name); err != nil {
+			return p, err
+		}
+	default:
+		return p, ErrUnexpectedValue
+	}
+	if p.Kind() == reflect.Interface {
+		if p = p.Elem(); !p.IsValid() {
+			return p, ErrOutOfBounds
+		}
+	}
+	return p, nil
+}
+
+// Convert string key to the key type expected by composite value
+func coerceKey(p reflect.Value, key interface{}) (interface{}, error) {
+	segment, ok := key.(string)
+	if !ok {
+		return key, nil
+	}
+	p = indirectComposite(p)
+	switch p.Kind() {
+	case reflect.Slice, reflect.Array:
+		index, err := arrayIndex(segment)
+		if err != nil {
+			return nil, err
+		}
+		return index, nil
+	case reflect.Map:
+		keyType := p.Type().Key()
+		switch kind := keyType.Kind(); {
+		case kind == reflect.Interface:
+			return segment, nil
+		case isString(kind):
+			return reflect.ValueOf(segment).Convert(keyType).Interface(), nil
+		case isPrimitives(kind):
+			v := Of(segment).to(kind)
+			if v.Err() != nil {
+				return nil, ErrInvalidArgument
+			}
+			return reflect.ValueOf(v.V()).Convert(keyType).Interface(), nil
+		}
+		return nil, ErrInvalidArgument
+	}
+	return segment, nil
+}
+
+// Parse array index of path segment, only "0" or digits without leading zeros are allowed (RFC 6901).
+// Returns ErrOutOfRange for "-" which references the element after the last one
+func arrayIndex(segment string) (int, error) {
+	if segment == "-" {
+		return 0, ErrOutOfRange
+	}
+	if segment == "" || !isDigits(segment) || (len(segment) > 1 && segment[0] == '0') {
+		return 0, ErrInvalidArgument
+	}
+	index, err := strconv.Atoi(segment)
+	if err != nil {
+		return 0, ErrInvalidArgument
+	}
+	return index, nil
+}
+
+// Returns value dereferenced by pointers and interfaces, invalid value returned for nil references
+func indirectComposite(p reflect.Value) reflect.Value {
+	for p.Kind() == reflect.Ptr || p.Kind() == reflect.Interface {
+		if p.IsNil() {
+			return reflect.Value{}
+		}
+		p = p.Elem()
+	}
+	return p
+}
+
+// Parse path in dot notation into keys
+func parseDotPath(path string) ([]interface{}, error) {
+	if path == "" {
+		return nil, ErrInvalidArgument
+	}
+	segments := strings.Split(path, ".")
+	keys := make([]interface{}, len(segments))
+	for i, v := range segments {
+		if v == "" {
+			return nil, ErrInvalidArgument
+		}
+		keys[i] = v
+	}
+	return keys, nil
+}
+
+// Parse JSON Pointer (RFC 6901) into keys
+func parsePointer(pointer string) ([]interface{}, error) {
+	if pointer == "" {
+		return []interface{}{}, nil
+	}
+	if pointer[0] != '/' {
+		return nil, ErrInvalidArgument
+	}
+	segments := strings.Split(pointer[1:], "/")
+	keys := make([]interface{}, len(segments))
+	for i, v := range segments {
+		if strings.Contains(v, "~") {
+			for j := 0; j < len(v); j++ {
+				if v[j] == '~' && (j == len(v)-1 || (v[j+1] != '0' && v[j+1] != '1')) {
+					return nil, ErrInvalidArgument
+				}
+			}
+			v = strings.ReplaceAll(strings.ReplaceAll(v, "~1", "/"), "~0", "~")
+		}
+		keys[i] = v
+	}
+	return keys, nil
+}
+
+// Retrieve struct field by name or `json` tag name, fields of embedded structs are promoted
+func structField(p reflect.Value, name string) (reflect.Value, error) {
+	sf, ok := p.Type().FieldByName(name)
+	if !ok {
+		if sf, ok = structFieldByTag(p.Type(), name); !ok {
+			return reflect.Value{}, ErrOutOfBounds
+		}
+	}
+	if sf.PkgPath != "" {
+		return reflect.Value{}, ErrUnexportedField
+	}
+	v, err := p.FieldByIndexErr(sf.Index)
+	if err != nil {
+		return reflect.Value{}, ErrOutOfBounds
+	}
+	return v, nil
+}
+
+// Retrieve struct field by `json` tag name, fields of embedded structs are searched in depth
+func structFieldByTag(rt reflect.Type, name string) (reflect.StructField, bool) {
+	var embedded []reflect.StructField
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		if tagName, ok := jsonFieldName(sf); ok && tagName == name {
+			return sf, true
+		}
+		if sf.Anonymous {
+			embedded = append(embedded, sf)
+		}
+	}
+	for _, sf := range embedded {
+		ft := sf.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if ft.Kind() != reflect.Struct {
+			continue
+		}
+		if esf, ok := structFieldByTag(ft, name); ok {
+			esf.Index = append(append([]int{}, sf.Index...), esf.Index...)
+			return esf, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// Returns name of struct field from `json` tag, false is returned if tag has no name or field is ignored by "-"
+func jsonFieldName(sf reflect.StructField) (string, bool) {
+	tag := sf.Tag.Get("json")
+	if i := strings.Index(tag, ","); i >= 0 {
+		tag = tag[:i]
+	}
+	if tag == "" || tag == "-" {
+		return "", false
+	}
+	return tag, true
+}
+
+// Returns path of index keys in dot notation
+func keyPath(keys []interface{}) string {
+	segments := make([]string, len(keys))
+	for i, v := range keys {
+		segments[i] = fmt.Sprint(v)
+	}
+	return strings.Join(segments, ".")
+}
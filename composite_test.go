@@ -6,18 +6,31 @@ import (
 )
 
 type (
-	TypedArray [6]interface{}
-	TypedSlice []interface{}
-	StructType struct{}
+	TypedArray     [6]interface{}
+	TypedSlice     []interface{}
+	StructType     struct{}
+	StructEmbedded struct {
+		Embedded string `json:"embedded"`
+	}
+	StructFieldsType struct {
+		*StructEmbedded
+		Name       string                 `json:"name"`
+		Skipped    string                 `json:"-"`
+		Nested     *StructFieldsType      `json:"nested,omitempty"`
+		Map        map[string]interface{} `json:"map"`
+		Iface      interface{}
+		unexported int
+	}
 )
 
 var (
-	MapData        map[string]interface{}
-	ArrayData      [6]interface{}
-	SliceData      []interface{}
-	TypedArrayData TypedArray
-	TypedSliceData TypedSlice
-	StructTypeData StructType
+	MapData          map[string]interface{}
+	ArrayData        [6]interface{}
+	SliceData        []interface{}
+	TypedArrayData   TypedArray
+	TypedSliceData   TypedSlice
+	StructTypeData   StructType
+	StructFieldsData StructFieldsType
 )
 
 func init() {
@@ -31,6 +44,14 @@ func init() {
 	TypedArrayData = TypedArray{1, 2, nil}
 	TypedSliceData = TypedSlice{1, 2, nil}
 	StructTypeData = StructType{}
+	StructFieldsData = StructFieldsType{
+		StructEmbedded: &StructEmbedded{"embedded"},
+		Name:           "name",
+		Skipped:        "skipped",
+		Nested:         &StructFieldsType{Name: "nested"},
+		Map:            map[string]interface{}{"slice": SliceData},
+		Iface:          &StructFieldsType{Name: "iface"},
+	}
 	MapData = map[string]interface{}{
 		"one": map[string]string{
 			"sub_one": "1",
@@ -206,6 +227,136 @@ func TestCompositeGet(t *testing.T) {
 			nil,
 			ErrUnexpectedValue,
 		},
+		{
+			// struct, by field name
+			StructFieldsData,
+			[]interface{}{"Name"},
+			true,
+			"name",
+			nil,
+		},
+		{
+			// struct, by json tag name
+			StructFieldsData,
+			[]interface{}{"name"},
+			true,
+			"name",
+			nil,
+		},
+		{
+			// struct, by field name with omitted json tag
+			StructFieldsData,
+			[]interface{}{"Skipped"},
+			true,
+			"skipped",
+			nil,
+		},
+		{
+			// struct, embedded by json tag name
+			StructFieldsData,
+			[]interface{}{"embedded"},
+			true,
+			"embedded",
+			nil,
+		},
+		{
+			// struct, embedded by field name
+			&StructFieldsData,
+			[]interface{}{"Embedded"},
+			true,
+			"embedded",
+			nil,
+		},
+		{
+			// struct, nested pointer
+			StructFieldsData,
+			[]interface{}{"nested", "name"},
+			true,
+			"nested",
+			nil,
+		},
+		{
+			// struct, nested pointer in interface
+			StructFieldsData,
+			[]interface{}{"Iface", "Name"},
+			true,
+			"iface",
+			nil,
+		},
+		{
+			// struct, map and slice inside
+			StructFieldsData,
+			[]interface{}{"map", "slice", 1},
+			true,
+			2,
+			nil,
+		},
+		{
+			// struct in map
+			map[string]interface{}{"struct": StructFieldsData},
+			[]interface{}{"struct", "name"},
+			true,
+			"name",
+			nil,
+		},
+		{
+			// invalid, nil Value given in map
+			map[string]interface{}{"nil": nil},
+			[]interface{}{"nil"},
+			false,
+			nil,
+			ErrOutOfBounds,
+		},
+		{
+			// invalid, nil pointer
+			StructFieldsData,
+			[]interface{}{"nested", "nested", "name"},
+			false,
+			nil,
+			ErrOutOfBounds,
+		},
+		{
+			// invalid, nil embedded pointer
+			StructFieldsType{},
+			[]interface{}{"embedded"},
+			false,
+			nil,
+			ErrOutOfBounds,
+		},
+		{
+			// invalid, field not exists
+			StructFieldsData,
+			[]interface{}{"invalid"},
+			false,
+			nil,
+			ErrOutOfBounds,
+		},
+		{
+			// invalid, unexported field
+			StructFieldsData,
+			[]interface{}{"unexported"},
+			false,
+			nil,
+			ErrUnexportedField,
+		},
+		{
+			// invalid, field ignored by json tag
+			StructFieldsData,
+			[]interface{}{"-"},
+			false,
+			nil,
+			ErrOutOfBounds,
+		},
+		{
+			// invalid, json tag without name
+			struct {
+				Options string `json:",omitempty"`
+			}{"options"},
+			[]interface{}{""},
+			false,
+			nil,
+			ErrOutOfBounds,
+		},
 	}
 	var (
 		test    bool
@@ -237,3 +388,145 @@ func BenchmarkOfGet(b *testing.B) {
 		Of(MapData).Get("one", "sub_one")
 	}
 }
+
+func TestCompositePath(t *testing.T) {
+	data := map[string]interface{}{
+		"users": []interface{}{
+			map[string]interface{}{
+				"address": &StructFieldsType{Name: "Berlin"},
+			},
+		},
+		"ints":   map[int]string{42: "int"},
+		"floats": map[float64]string{1.5: "float"},
+		"a.b":    "dot",
+	}
+	testData := []struct {
+		path     string
+		expected interface{}
+		err      error
+	}{
+		{"users.0.address.name", "Berlin", nil},
+		{"users.0.address.Name", "Berlin", nil},
+		{"ints.42", "int", nil},
+		{"floats.1.5", nil, ErrOutOfBounds},
+		{"users.1", nil, ErrOutOfRange},
+		{"users.first", nil, ErrInvalidArgument},
+		{"users.00", nil, ErrInvalidArgument},
+		{"users.+0", nil, ErrInvalidArgument},
+		{"users.0.invalid", nil, ErrOutOfBounds},
+		{"ints.invalid", nil, ErrInvalidArgument},
+		{"a.b", nil, ErrOutOfBounds},
+		{"users..0", nil, ErrInvalidArgument},
+		{"", nil, ErrInvalidArgument},
+	}
+	for _, v := range testData {
+		value := Of(data).Path(v.path).Interface()
+		if value.Err() != v.err || (v.err == nil && !reflect.DeepEqual(value.V(), v.expected)) {
+			t.Errorf("Of(%v).Path(%q), %s", data, v.path, errNull{
+				v.expected, v.err == nil, v.err,
+				value.V(), value.Valid(), value.Err(),
+			})
+		}
+	}
+}
+
+func TestCompositePointer(t *testing.T) {
+	data := map[string]interface{}{
+		"users": []interface{}{
+			map[string]interface{}{
+				"address": &StructFieldsType{Name: "Berlin"},
+			},
+		},
+		"a/b":  "slash",
+		"m~n":  "tilde",
+		"":     "empty",
+		"ints": map[int8]string{1: "int8"},
+	}
+	testData := []struct {
+		pointer  string
+		expected interface{}
+		err      error
+	}{
+		{"/users/0/address/name", "Berlin", nil},
+		{"/a~1b", "slash", nil},
+		{"/m~0n", "tilde", nil},
+		{"/", "empty", nil},
+		{"/ints/1", "int8", nil},
+		{"/ints/300", nil, ErrInvalidArgument},
+		{"/users/-", nil, ErrOutOfRange},
+		{"/users/01x", nil, ErrInvalidArgument},
+		{"/users/01", nil, ErrInvalidArgument},
+		{"/users/+0", nil, ErrInvalidArgument},
+		{"/users/-0", nil, ErrInvalidArgument},
+		{"/m~2n", nil, ErrInvalidArgument},
+		{"/m~", nil, ErrInvalidArgument},
+		{"users", nil, ErrInvalidArgument},
+	}
+	for _, v := range testData {
+		value := Of(data).Pointer(v.pointer).Interface()
+		if value.Err() != v.err || (v.err == nil && !reflect.DeepEqual(value.V(), v.expected)) {
+			t.Errorf("Of(%v).Pointer(%q), %s", data, v.pointer, errNull{
+				v.expected, v.err == nil, v.err,
+				value.V(), value.Valid(), value.Err(),
+			})
+		}
+	}
+	if value := Of(data).Pointer("").Interface(); !value.Valid() || !reflect.DeepEqual(value.V(), data) {
+		t.Errorf("Of(%v).Pointer(\"\"), whole value expected", data)
+	}
+}
+
+func TestCompilePath(t *testing.T) {
+	testData := [][]interface{}{
+		{MapData, []interface{}{"one", "sub_one"}},
+		{MapData, []interface{}{"one", "invalid"}},
+		{MapData, []interface{}{"one", 2}},
+		{MapData, []interface{}{"array", 1}},
+		{&MapData, []interface{}{"slice", 2}},
+		{MapData, []interface{}{"slice", 3}},
+		{StructFieldsData, []interface{}{"nested", "name"}},
+		{StructFieldsData, []interface{}{"unexported"}},
+		{StructTypeData, []interface{}{1}},
+		{nil, []interface{}{1}},
+		{(*StructFieldsType)(nil), []interface{}{"name"}},
+	}
+	for _, v := range testData {
+		path := CompilePath(v[1].([]interface{})...)
+		expected := Of(v[0]).Get(v[1].([]interface{})...).Interface()
+		for _, from := range []interface{}{v[0], Of(v[0])} {
+			value := path.From(from).Interface()
+			if value.Err() != expected.Err() || !reflect.DeepEqual(value.V(), expected.V()) {
+				t.Errorf("CompilePath(%v).From(%v), %s", v[1], from, errNull{
+					expected.V(), expected.Valid(), expected.Err(),
+					value.V(), value.Valid(), value.Err(),
+				})
+			}
+		}
+	}
+	for _, keys := range [][]interface{}{{}, {nil}, {"one", []int{1}}} {
+		if value := CompilePath(keys...).From(MapData); value.Error() != ErrInvalidArgument {
+			t.Errorf("CompilePath(%v).From(%v) failed, expected error %v instead of %v", keys, MapData, ErrInvalidArgument, value.Error())
+		}
+	}
+	keys := []interface{}{"one", "sub_one"}
+	path := CompilePath(keys...)
+	keys[1], path.Keys()[1] = "invalid", "invalid"
+	if value := path.From(MapData).String(); value.V() != "1" {
+		t.Errorf("CompilePath(%v) failed, path must be immutable", keys)
+	}
+	if value := path.From(MapData, Prefix("_")).String(); value.V() != "_1" {
+		t.Errorf("CompilePath(%v).From(%v, Prefix(\"_\")) failed, options must be applied", keys, MapData)
+	}
+	if value := path.From(Of(MapData, Prefix("_")), Suffix("_")).String(); value.V() != "_1_" {
+		t.Errorf("CompilePath(%v).From(Of(%v, Prefix(\"_\")), Suffix(\"_\")) failed, options must be applied over options of Type instead of %q", keys, MapData, value.V())
+	}
+}
+
+// BenchmarkPathFrom-8   	 3724929	       455 ns/op	     352 B/op	       3 allocs/op
+func BenchmarkPathFrom(b *testing.B) {
+	path := CompilePath("one", "sub_one")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		path.From(MapData)
+	}
+}
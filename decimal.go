@@ -0,0 +1,313 @@
+package typ
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// maxDecimalExponent is a limit of exponent in decimal strings, it prevents huge allocations for values like "1e1000000000"
+const maxDecimalExponent = 10000
+
+var (
+	decimalType = reflect.TypeOf(Decimal{})
+	bigTen      = big.NewInt(10)
+)
+
+// Decimal represents an exact fixed-point decimal number as unscaled integer value and scale,
+// value is unscaled * 10^-scale. Decimal is immutable, zero value is 0
+type Decimal struct {
+	unscaled *big.Int
+	scale    int
+}
+
+// NewDecimal returns Decimal of unscaled * 10^-scale, e.g. NewDecimal(12345, 2) is 123.45
+func NewDecimal(unscaled int64, scale int) Decimal {
+	if scale < 0 {
+		return Decimal{new(big.Int).Mul(big.NewInt(unscaled), pow10(-scale)), 0}
+	}
+	return Decimal{big.NewInt(unscaled), scale}
+}
+
+// ParseDecimal parse decimal number exactly, e.g. "-123.4500" or "1.5e3".
+// Scale of result is count of fractional digits including trailing zeros
+func ParseDecimal(s string) (Decimal, error) {
+	mantissa, exp := s, 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.Atoi(s[i+1:])
+		if err != nil || e > maxDecimalExponent || e < -maxDecimalExponent {
+			return Decimal{}, ErrConvert
+		}
+		mantissa, exp = s[:i], e
+	}
+	sign := ""
+	if mantissa != "" && (mantissa[0] == '-' || mantissa[0] == '+') {
+		sign, mantissa = mantissa[:1], mantissa[1:]
+	}
+	intPart, fracPart := mantissa, ""
+	if i := strings.IndexByte(mantissa, '.'); i >= 0 {
+		intPart, fracPart = mantissa[:i], mantissa[i+1:]
+	}
+	if intPart == "" && fracPart == "" || !isDigits(intPart) || !isDigits(fracPart) {
+		return Decimal{}, ErrConvert
+	}
+	unscaled, _ := new(big.Int).SetString(sign+intPart+fracPart, 10)
+	scale := len(fracPart) - exp
+	if scale < 0 {
+		return Decimal{unscaled.Mul(unscaled, pow10(-scale)), 0}, nil
+	}
+	return Decimal{unscaled, scale}, nil
+}
+
+// Scale returns count of fractional digits
+func (d Decimal) Scale() int {
+	return d.scale
+}
+
+// Unscaled returns copy of unscaled integer value
+func (d Decimal) Unscaled() *big.Int {
+	if d.unscaled == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(d.unscaled)
+}
+
+// Rescale returns decimal with given scale.
+// Returns false if scale is negative or non-zero fractional digits would be lost
+func (d Decimal) Rescale(scale int) (Decimal, bool) {
+	if scale < 0 {
+		return Decimal{}, false
+	}
+	unscaled := d.Unscaled()
+	if scale >= d.scale {
+		return Decimal{unscaled.Mul(unscaled, pow10(scale-d.scale)), scale}, true
+	}
+	var rem big.Int
+	if unscaled.QuoRem(unscaled, pow10(d.scale-scale), &rem); rem.Sign() != 0 {
+		return Decimal{}, false
+	}
+	return Decimal{unscaled, scale}, true
+}
+
+// Rat returns exact value as *big.Rat
+func (d Decimal) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(d.Unscaled(), pow10(d.scale))
+}
+
+// IsInt determines whether a value has no fractional part
+func (d Decimal) IsInt() bool {
+	return d.Rat().IsInt()
+}
+
+// Sign returns -1, 0 or +1 depending on sign of value
+func (d Decimal) Sign() int {
+	return d.Unscaled().Sign()
+}
+
+// Cmp compares values regardless of scale, returns -1 if d < x, 0 if d == x and +1 if d > x
+func (d Decimal) Cmp(x Decimal) int {
+	a, b := alignDecimals(d, x)
+	return a.Cmp(b)
+}
+
+// Add returns d + x with the larger scale of operands
+func (d Decimal) Add(x Decimal) Decimal {
+	a, b := alignDecimals(d, x)
+	return Decimal{a.Add(a, b), maxInt(d.scale, x.scale)}
+}
+
+// Sub returns d - x with the larger scale of operands
+func (d Decimal) Sub(x Decimal) Decimal {
+	a, b := alignDecimals(d, x)
+	return Decimal{a.Sub(a, b), maxInt(d.scale, x.scale)}
+}
+
+// Mul returns d * x with the sum of operand scales
+func (d Decimal) Mul(x Decimal) Decimal {
+	unscaled := d.Unscaled()
+	return Decimal{unscaled.Mul(unscaled, x.Unscaled()), d.scale + x.scale}
+}
+
+// Neg returns -d
+func (d Decimal) Neg() Decimal {
+	unscaled := d.Unscaled()
+	return Decimal{unscaled.Neg(unscaled), d.scale}
+}
+
+// String returns decimal in plain notation with all fractional digits, e.g. "-0.0500"
+func (d Decimal) String() string {
+	digits := d.Unscaled().String()
+	sign := ""
+	if digits[0] == '-' {
+		sign, digits = "-", digits[1:]
+	}
+	if d.scale == 0 {
+		return sign + digits
+	}
+	if len(digits) <= d.scale {
+		digits = strings.Repeat("0", d.scale-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-d.scale] + "." + digits[len(digits)-d.scale:]
+}
+
+// DecimalScale set scale of decimal values, values are padded by zeros.
+// The conversion is rejected if non-zero fractional digits would be lost
+func DecimalScale(scale int) Option {
+	return func(t *opts) error {
+		if scale < 0 {
+			return ErrInvalidArgument
+		}
+		t.scale = &scale
+		return nil
+	}
+}
+
+// Decimal convert interface value to Decimal.
+// Strings & integers are converted exactly, floats by their shortest decimal representation.
+// Returns value if type can safely converted, otherwise error & default value in result values
+func (t *Type) Decimal(defaultValue ...Decimal) DecimalAccessor {
+	nv := &NullDecimal{}
+	if nv.Error = t.err; t.err != nil {
+		return nv
+	}
+	nv = t.toDecimal()
+	defaultDecimal(nv, defaultValue...)
+	return nv
+}
+
+// Convert interface value to Decimal.
+// Returns error if type can't safely converted
+func (t *Type) toDecimal() *NullDecimal {
+	nv := &NullDecimal{}
+	if !t.rv.IsValid() {
+		nv.Error = t.newConversionError(reflect.Struct, nil)
+		return nv
+	}
+	var (
+		v   Decimal
+		err error
+	)
+	switch {
+	case t.rv.Type() == decimalType:
+		v = t.rv.Interface().(Decimal)
+	case t.rv.Type() == bigIntType:
+		v = Decimal{new(big.Int).Set(bigPointer(t.rv).(*big.Int)), 0}
+	case t.rv.Type() == bigFloatType:
+		if f := bigPointer(t.rv).(*big.Float); !f.IsInf() {
+			v, err = ParseDecimal(f.Text('f', -1))
+			break
+		}
+		err = ErrConvert
+	case t.rv.Type() == bigRatType:
+		v, err = ratDecimal(bigPointer(t.rv).(*big.Rat))
+	case t.IsString(true):
+		v, err = ParseDecimal(strings.TrimSpace(t.rv.String()))
+	case t.IsInt(true):
+		v = Decimal{big.NewInt(t.rv.Int()), 0}
+	case t.IsUint(true):
+		v = Decimal{new(big.Int).SetUint64(t.rv.Uint()), 0}
+	case t.IsFloat(true) && !math.IsNaN(t.rv.Float()) && !math.IsInf(t.rv.Float(), 0):
+		v, err = ParseDecimal(strconv.FormatFloat(t.rv.Float(), 'f', -1, bitSizeMap[t.Kind(true)]))
+	default:
+		err = ErrConvert
+	}
+	if err == nil && t.opts.scale != nil {
+		var ok bool
+		if v, ok = v.Rescale(*t.opts.scale); !ok {
+			err = ErrConvert
+		}
+	}
+	if err != nil {
+		nv.Error = t.newConversionError(reflect.Struct, err)
+		return nv
+	}
+	nv.P = &v
+	return nv
+}
+
+// Returns the narrowest exact Go value of decimal, e.g. int64 or uint64 for integral values, float64 for fractional values.
+// Returns false if value can't be represented by float64 without loss of digits
+func decimalValue(d Decimal) (interface{}, bool) {
+	if r := d.Rat(); r.IsInt() {
+		return bigIntValue(r.Num())
+	}
+	f, err := strconv.ParseFloat(d.String(), 64)
+	if err != nil {
+		return nil, false
+	}
+	if v, err := ParseDecimal(strconv.FormatFloat(f, 'f', -1, 64)); err != nil || v.Cmp(d) != 0 {
+		return nil, false
+	}
+	return f, true
+}
+
+// Convert rational number to decimal, returns error if it has infinite decimal expansion
+func ratDecimal(r *big.Rat) (Decimal, error) {
+	denom := new(big.Int).Set(r.Denom())
+	var rem big.Int
+	scale := 0
+	for _, p := range []*big.Int{big.NewInt(2), big.NewInt(5)} {
+		n := 0
+		for denom.Cmp(big.NewInt(1)) != 0 {
+			var q big.Int
+			if q.QuoRem(denom, p, &rem); rem.Sign() != 0 {
+				break
+			}
+			denom.Set(&q)
+			n++
+		}
+		scale = maxInt(scale, n)
+	}
+	if denom.Cmp(big.NewInt(1)) != 0 {
+		return Decimal{}, ErrConvert
+	}
+	unscaled := new(big.Int).Mul(r.Num(), pow10(scale))
+	return Decimal{unscaled.Quo(unscaled, r.Denom()), scale}, nil
+}
+
+// Returns unscaled values of decimals aligned to the larger scale
+func alignDecimals(a, b Decimal) (*big.Int, *big.Int) {
+	scale := maxInt(a.scale, b.scale)
+	x, _ := a.Rescale(scale)
+	y, _ := b.Rescale(scale)
+	return x.unscaled, y.unscaled
+}
+
+// Returns 10^n
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+// Determine whether a string consists of decimal digits only
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns the larger of x or y
+func maxInt(x, y int) int {
+	if x > y {
+		return x
+	}
+	return y
+}
+
+// Set default decimal value if decimal isn't valid
+func defaultDecimal(nv *NullDecimal, defaultValue ...Decimal) bool {
+	if len(defaultValue) > 1 {
+		nv.Error = ErrDefaultValue
+		return true
+	}
+	if !nv.Valid() && len(defaultValue) > 0 {
+		v := defaultValue[0]
+		nv.P = &v
+		return true
+	}
+	return false
+}
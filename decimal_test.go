@@ -0,0 +1,152 @@
+package typ
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestParseDecimal(t *testing.T) {
+	testData := []struct {
+		value    string
+		expected string
+		scale    int
+		err      error
+	}{
+		{"123.4500", "123.4500", 4, nil},
+		{"-0.05", "-0.05", 2, nil},
+		{"+.5", "0.5", 1, nil},
+		{"7.", "7", 0, nil},
+		{"1.5e3", "1500", 0, nil},
+		{"1.5E-3", "0.0015", 4, nil},
+		{"123456789012345678901234567890.123456789", "123456789012345678901234567890.123456789", 9, nil},
+		{"", "", 0, ErrConvert},
+		{".", "", 0, ErrConvert},
+		{"1.2.3", "", 0, ErrConvert},
+		{"1e", "", 0, ErrConvert},
+		{"1e100000", "", 0, ErrConvert},
+		{"0x10", "", 0, ErrConvert},
+		{"--1", "", 0, ErrConvert},
+	}
+	for _, v := range testData {
+		d, err := ParseDecimal(v.value)
+		if (v.err == nil && (d.String() != v.expected || d.Scale() != v.scale)) || !errors.Is(err, v.err) {
+			t.Errorf("ParseDecimal(%q) failed, expected (expected == actual) %v == %v, scale %d == %d, error %v == %v",
+				v.value, v.expected, d, v.scale, d.Scale(), v.err, err,
+			)
+		}
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	a, b := NewDecimal(12345, 2), NewDecimal(-5, 3)
+	if v := a.Add(b); v.String() != "123.445" {
+		t.Errorf("Decimal.Add() failed, expected 123.445 instead of %v", v)
+	}
+	if v := a.Sub(b); v.String() != "123.455" {
+		t.Errorf("Decimal.Sub() failed, expected 123.455 instead of %v", v)
+	}
+	if v := a.Mul(b); v.String() != "-0.61725" {
+		t.Errorf("Decimal.Mul() failed, expected -0.61725 instead of %v", v)
+	}
+	if v := b.Neg(); v.String() != "0.005" || v.Sign() != 1 {
+		t.Errorf("Decimal.Neg() failed, expected 0.005 instead of %v", v)
+	}
+	if a.Cmp(NewDecimal(1234500, 4)) != 0 || a.Cmp(b) != 1 || b.Cmp(a) != -1 {
+		t.Errorf("Decimal.Cmp() failed")
+	}
+	if v, ok := a.Rescale(4); !ok || v.String() != "123.4500" {
+		t.Errorf("Decimal.Rescale(4) failed, expected 123.4500 instead of %v", v)
+	}
+	if _, ok := a.Rescale(1); ok {
+		t.Errorf("Decimal.Rescale(1) failed, digits must not be lost")
+	}
+	if v, ok := NewDecimal(12300, 2).Rescale(0); !ok || v.String() != "123" {
+		t.Errorf("Decimal.Rescale(0) failed, expected 123 instead of %v", v)
+	}
+	if v := NewDecimal(5, -2); v.String() != "500" || !v.IsInt() {
+		t.Errorf("NewDecimal(5, -2) failed, expected 500 instead of %v", v)
+	}
+	if (Decimal{}).String() != "0" || (Decimal{}).Sign() != 0 {
+		t.Errorf("Decimal{} failed, expected 0 instead of %v", Decimal{})
+	}
+	if r := a.Rat(); r.Cmp(big.NewRat(2469, 20)) != 0 {
+		t.Errorf("Decimal.Rat() failed, expected 2469/20 instead of %v", r)
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	testData := []struct {
+		value    interface{}
+		options  []Option
+		expected string
+		err      error
+	}{
+		{"123.4500", nil, "123.4500", nil},
+		{" 42 ", nil, "42", nil},
+		{json.Number("0.1"), nil, "0.1", nil},
+		{42, nil, "42", nil},
+		{uint64(math.MaxUint64), nil, "18446744073709551615", nil},
+		{0.1, nil, "0.1", nil},
+		{float32(0.1), nil, "0.1", nil},
+		{math.NaN(), nil, "", ErrConvert},
+		{math.Inf(1), nil, "", ErrConvert},
+		{big.NewInt(7), nil, "7", nil},
+		{big.NewFloat(1.25), nil, "1.25", nil},
+		{big.NewRat(3, 8), nil, "0.375", nil},
+		{big.NewRat(1, 3), nil, "", ErrConvert},
+		{NewDecimal(5, 1), nil, "0.5", nil},
+		{"12.5", []Option{DecimalScale(4)}, "12.5000", nil},
+		{"12.50", []Option{DecimalScale(1)}, "12.5", nil},
+		{"12.55", []Option{DecimalScale(1)}, "", ErrConvert},
+		{"12.5", []Option{DecimalScale(-1)}, "", ErrInvalidArgument},
+		{"invalid", nil, "", ErrConvert},
+		{true, nil, "", ErrConvert},
+		{nil, nil, "", ErrConvert},
+	}
+	for _, v := range testData {
+		nv := Of(v.value, v.options...).Decimal()
+		if (v.err == nil && nv.V().String() != v.expected) || !errors.Is(nv.Err(), v.err) {
+			t.Errorf("Of(%v).Decimal() failed, expected (expected == actual) %v == %v, error %v == %v",
+				v.value, v.expected, nv.V(), v.err, nv.Err(),
+			)
+		}
+	}
+	if nv := Of("invalid").Decimal(NewDecimal(1, 0)); nv.V().String() != "1" || !errors.Is(nv.Err(), ErrConvert) {
+		t.Errorf("Of(invalid).Decimal() failed, expected default value 1 instead of %v, error %v", nv.V(), nv.Err())
+	}
+}
+
+func TestDecimalSource(t *testing.T) {
+	mustDecimal := func(s string) Decimal {
+		d, err := ParseDecimal(s)
+		if err != nil {
+			t.Fatalf("ParseDecimal(%q) failed, error %v", s, err)
+		}
+		return d
+	}
+	if nv := Of(mustDecimal("1234.0000")).Int64(); nv.V() != 1234 || nv.Err() != nil {
+		t.Errorf("Of(Decimal(1234.0000)).Int64() failed, expected 1234 instead of %v, error %v", nv.V(), nv.Err())
+	}
+	var ce *ConversionError
+	if nv := Of(mustDecimal("1234.5")).Int64(); !errors.As(nv.Err(), &ce) || ce.Reason != ReasonPrecision {
+		t.Errorf("Of(Decimal(1234.5)).Int64() failed, expected precision error instead of %v", nv.Err())
+	}
+	if nv := Of(mustDecimal("1234.5")).Float(); nv.V() != 1234.5 || nv.Err() != nil {
+		t.Errorf("Of(Decimal(1234.5)).Float() failed, expected 1234.5 instead of %v, error %v", nv.V(), nv.Err())
+	}
+	if nv := Of(mustDecimal("0.12345678901234567890")).Float(); !errors.As(nv.Err(), &ce) || ce.Reason != ReasonPrecision {
+		t.Errorf("Of(Decimal(0.12345678901234567890)).Float() failed, expected precision error instead of %v", nv.Err())
+	}
+	if nv := Of(mustDecimal("300")).Int8(); !errors.As(nv.Err(), &ce) || ce.Reason != ReasonOverflow {
+		t.Errorf("Of(Decimal(300)).Int8() failed, expected overflow error instead of %v", nv.Err())
+	}
+	if nv := Of(mustDecimal("-0.0500")).String(); nv.V() != "-0.0500" || nv.Err() != nil {
+		t.Errorf("Of(Decimal(-0.0500)).String() failed, expected -0.0500 instead of %v, error %v", nv.V(), nv.Err())
+	}
+	if nv := Of(mustDecimal("1.25")).BigRat(); nv.V().RatString() != "5/4" || nv.Err() != nil {
+		t.Errorf("Of(Decimal(1.25)).BigRat() failed, expected 5/4 instead of %v, error %v", nv.V(), nv.Err())
+	}
+}
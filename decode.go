@@ -0,0 +1,282 @@
+package typ
+
+import (
+	"database/sql"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// FieldError is returned when value can't be decoded into the field located by path
+type FieldError struct {
+	Path string
+	Err  error
+}
+
+// Error returns description of failed field
+func (e *FieldError) Error() string {
+	if e.Path == "" {
+		return e.Err.Error()
+	}
+	return e.Path + ": " + e.Err.Error()
+}
+
+// Unwrap returns underlying error
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// DecodeError is returned when one or more fields can't be decoded, it lists every failed field
+type DecodeError struct {
+	Errors []*FieldError
+}
+
+// Error returns description of all failed fields
+func (e *DecodeError) Error() string {
+	msg := make([]string, 0, len(e.Errors))
+	for _, v := range e.Errors {
+		msg = append(msg, v.Error())
+	}
+	return "can't decode " + strconv.Itoa(len(e.Errors)) + " field(s): " + strings.Join(msg, "; ")
+}
+
+type decoder struct {
+	options []Option
+	errors  []*FieldError
+}
+
+// Decode assigns unstructured data from src into dst, dst must be a non-nil pointer.
+// Maps are decoded into structs using field name (case-insensitive) or name from `typ` struct tag,
+// tag "-" skips the field, tag option "required" reports missing keys, e.g. `typ:"name,required"`.
+// Primitive values are converted by the same safe rules as Of(value, options...),
+// types implementing sql.Scanner (e.g. Null types) receive the source value through Scan.
+// Returns *DecodeError with every field that failed to decode
+func Decode(src interface{}, dst interface{}, options ...Option) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return ErrInvalidArgument
+	}
+	t := Of(src, options...)
+	if t.err != nil {
+		return t.err
+	}
+	d := &decoder{options: options}
+	d.decode("", t.rv, rv.Elem())
+	if len(d.errors) > 0 {
+		return &DecodeError{d.errors}
+	}
+	return nil
+}
+
+// Register error of field located by path
+func (d *decoder) fail(path string, err error) {
+	d.errors = append(d.errors, &FieldError{path, err})
+}
+
+// Decode src value into settable dst value
+func (d *decoder) decode(path string, src, dst reflect.Value) {
+	src = indirectValue(src)
+	if !src.IsValid() {
+		return
+	}
+	if dst.CanAddr() {
+		if scanner, ok := dst.Addr().Interface().(sql.Scanner); ok {
+			if err := scanner.Scan(src.Interface()); err != nil {
+				d.fail(path, err)
+			}
+			return
+		}
+	}
+	if src.Type().AssignableTo(dst.Type()) && !isComposite(src.Kind()) {
+		dst.Set(src)
+		return
+	}
+	switch kind := dst.Kind(); {
+	case kind == reflect.Ptr:
+		v := reflect.New(dst.Type().Elem())
+		d.decode(path, src, v.Elem())
+		dst.Set(v)
+	case kind == reflect.Interface:
+		if !src.Type().AssignableTo(dst.Type()) {
+			d.fail(path, ErrUnexpectedValue)
+			return
+		}
+		dst.Set(src)
+	case isPrimitives(kind) || isString(kind):
+		d.decodePrimitive(path, src, dst)
+	case kind == reflect.Struct:
+		d.decodeStruct(path, src, dst)
+	case kind == reflect.Slice, kind == reflect.Array:
+		d.decodeSlice(path, src, dst)
+	case kind == reflect.Map:
+		d.decodeMap(path, src, dst)
+	default:
+		d.fail(path, ErrUnexpectedValue)
+	}
+}
+
+// Decode src value into primitive dst value
+func (d *decoder) decodePrimitive(path string, src, dst reflect.Value) {
+	t := NewType(src.Interface(), nil, d.options...)
+	var valueTo InterfaceAccessor
+	if isString(dst.Kind()) {
+		sv := t.String()
+		valueTo = &NullInterface{InterfaceCommon{P: sv.V(), Error: sv.Err()}}
+	} else {
+		valueTo = t.to(dst.Kind())
+	}
+	if valueTo.Err() != nil {
+		d.fail(path, valueTo.Err())
+		return
+	}
+	dst.Set(reflect.ValueOf(valueTo.V()).Convert(dst.Type()))
+}
+
+// Decode map into struct
+func (d *decoder) decodeStruct(path string, src, dst reflect.Value) {
+	if src.Kind() != reflect.Map {
+		d.fail(path, ErrUnexpectedValue)
+		return
+	}
+	rt := dst.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		name, required, skip := structFieldTag(field)
+		if skip {
+			continue
+		}
+		if field.Anonymous && name == "" {
+			fv := dst.Field(i)
+			if fv.Kind() == reflect.Ptr {
+				if fv.IsNil() {
+					// Nil pointer to unexported struct can't be allocated, it's skipped as encoding/json does
+					if !fv.CanSet() {
+						continue
+					}
+					fv.Set(reflect.New(fv.Type().Elem()))
+				}
+				fv = fv.Elem()
+			}
+			if fv.Kind() == reflect.Struct {
+				d.decodeStruct(path, src, fv)
+				continue
+			}
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+		fold := name == ""
+		if fold {
+			name = field.Name
+		}
+		fieldPath := joinPath(path, name)
+		value, ok := mapIndexByName(src, name, fold)
+		if !ok {
+			if required {
+				d.fail(fieldPath, ErrOutOfBounds)
+			}
+			continue
+		}
+		d.decode(fieldPath, value, dst.Field(i))
+	}
+}
+
+// Decode slice or array into slice or array
+func (d *decoder) decodeSlice(path string, src, dst reflect.Value) {
+	if src.Kind() != reflect.Slice && src.Kind() != reflect.Array {
+		d.fail(path, ErrUnexpectedValue)
+		return
+	}
+	cnt := src.Len()
+	if dst.Kind() == reflect.Slice {
+		dst.Set(reflect.MakeSlice(dst.Type(), cnt, cnt))
+	}
+	for i := 0; i < cnt; i++ {
+		if i >= dst.Len() {
+			d.fail(joinPath(path, strconv.Itoa(i)), ErrOutOfRange)
+			continue
+		}
+		d.decode(joinPath(path, strconv.Itoa(i)), src.Index(i), dst.Index(i))
+	}
+}
+
+// Decode map into map, keys are converted to the key type of destination
+func (d *decoder) decodeMap(path string, src, dst reflect.Value) {
+	if src.Kind() != reflect.Map {
+		d.fail(path, ErrUnexpectedValue)
+		return
+	}
+	rt := dst.Type()
+	if dst.IsNil() {
+		dst.Set(reflect.MakeMapWithSize(rt, src.Len()))
+	}
+	iter := src.MapRange()
+	for iter.Next() {
+		keyPath := joinPath(path, Of(iter.Key().Interface()).String().V())
+		key := reflect.New(rt.Key()).Elem()
+		keyDecoder := &decoder{options: d.options}
+		if keyDecoder.decode(keyPath, iter.Key(), key); len(keyDecoder.errors) > 0 {
+			d.errors = append(d.errors, keyDecoder.errors...)
+			continue
+		}
+		value := reflect.New(rt.Elem()).Elem()
+		d.decode(keyPath, iter.Value(), value)
+		dst.SetMapIndex(key, value)
+	}
+}
+
+// Returns name from `typ` struct tag, whether the field is required or should be skipped
+func structFieldTag(field reflect.StructField) (name string, required, skip bool) {
+	tag := field.Tag.Get("typ")
+	if tag == "-" {
+		return "", false, true
+	}
+	parts := strings.Split(tag, ",")
+	for _, v := range parts[1:] {
+		if v == "required" {
+			required = true
+		}
+	}
+	return parts[0], required, false
+}
+
+// Returns map value by string key, if fold is true key compared in case-insensitive mode
+func mapIndexByName(m reflect.Value, name string, fold bool) (reflect.Value, bool) {
+	if !isString(m.Type().Key().Kind()) && m.Type().Key().Kind() != reflect.Interface {
+		return reflect.Value{}, false
+	}
+	key := reflect.ValueOf(name)
+	if isString(m.Type().Key().Kind()) {
+		key = key.Convert(m.Type().Key())
+	}
+	if v := m.MapIndex(key); v.IsValid() {
+		return v, true
+	}
+	if !fold {
+		return reflect.Value{}, false
+	}
+	iter := m.MapRange()
+	for iter.Next() {
+		k := indirectValue(iter.Key())
+		if isString(k.Kind()) && strings.EqualFold(k.String(), name) {
+			return iter.Value(), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// Returns value recursive dereferenced by a reference if value is a pointer or interface
+func indirectValue(rv reflect.Value) reflect.Value {
+	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+		rv = rv.Elem()
+	}
+	return rv
+}
+
+// Returns path joined with key by dot
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
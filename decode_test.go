@@ -0,0 +1,154 @@
+package typ
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type (
+	decodeAddress struct {
+		City string `typ:"city"`
+		Zip  int    `typ:"zip"`
+	}
+	decodeMeta struct {
+		Source string
+	}
+	decodeUser struct {
+		decodeMeta
+		ID       uint64            `typ:"id,required"`
+		Name     string            `typ:"name"`
+		Age      int8              `typ:"age"`
+		Score    float32           `typ:"score"`
+		Active   NullBool          `typ:"active"`
+		Rank     Null[int]         `typ:"rank"`
+		Address  *decodeAddress    `typ:"address"`
+		Tags     []string          `typ:"tags"`
+		Limits   map[string]uint16 `typ:"limits"`
+		Pair     [2]int            `typ:"pair"`
+		Extra    interface{}       `typ:"extra"`
+		Ignored  string            `typ:"-"`
+		Nickname string
+		private  string
+	}
+)
+
+func TestDecode(t *testing.T) {
+	src := map[string]interface{}{
+		"source": "api",
+		"id":     "42",
+		"name":   "Gopher",
+		"age":    30.0,
+		"score":  1.5,
+		"active": true,
+		"rank":   int64(7),
+		"address": map[string]interface{}{
+			"city": "Berlin",
+			"zip":  "10115",
+		},
+		"tags":     []interface{}{"a", 1, true},
+		"limits":   map[string]interface{}{"x": 1, "y": "2"},
+		"pair":     []int{1, 2},
+		"extra":    []int{1},
+		"Ignored":  "ignored",
+		"NICKNAME": "gopher",
+		"private":  "private",
+	}
+	var actual decodeUser
+	if err := Decode(src, &actual); err != nil {
+		t.Fatalf("Decode(%v) failed, unexpected error %v", src, err)
+	}
+	expected := decodeUser{
+		decodeMeta: decodeMeta{Source: "api"},
+		ID:         42,
+		Name:       "Gopher",
+		Age:        30,
+		Score:      1.5,
+		Active:     NullBool{BoolCommon{P: &[]bool{true}[0]}},
+		Rank:       Null[int]{P: &[]int{7}[0]},
+		Address:    &decodeAddress{City: "Berlin", Zip: 10115},
+		Tags:       []string{"a", "1", "true"},
+		Limits:     map[string]uint16{"x": 1, "y": 2},
+		Pair:       [2]int{1, 2},
+		Extra:      []int{1},
+		Nickname:   "gopher",
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Decode(%v) failed, expected (expected == actual) %+v == %+v", src, expected, actual)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	src := map[string]interface{}{
+		"name": "Gopher",
+		"age":  300,
+		"rank": 1.5,
+		"address": map[string]interface{}{
+			"zip": "abc",
+		},
+		"tags": "not a slice",
+		"pair": []int{1, 2, 3},
+	}
+	var actual decodeUser
+	err := Decode(src, &actual)
+	decodeErr, ok := err.(*DecodeError)
+	if !ok {
+		t.Fatalf("Decode(%v) failed, expected *DecodeError instead of %T(%[2]v)", src, err)
+	}
+	expected := map[string]error{
+		"id":          ErrOutOfBounds,
+		"age":         ErrConvert,
+		"rank":        ErrConvert,
+		"address.zip": nil,
+		"tags":        ErrUnexpectedValue,
+		"pair.2":      ErrOutOfRange,
+	}
+	if len(decodeErr.Errors) != len(expected) {
+		t.Errorf("Decode(%v) failed, expected %d errors instead of %d: %v", src, len(expected), len(decodeErr.Errors), err)
+	}
+	for _, v := range decodeErr.Errors {
+		e, ok := expected[v.Path]
+		if !ok || (e != nil && !errors.Is(v, e)) {
+			t.Errorf("Decode(%v) failed, unexpected error on path %q: %v", src, v.Path, v.Err)
+		}
+	}
+	if actual.Name != "Gopher" {
+		t.Errorf("Decode(%v) failed, valid fields must be decoded (expected == actual) %v == %v", src, "Gopher", actual.Name)
+	}
+}
+
+func TestDecodeRequiredFold(t *testing.T) {
+	type required struct {
+		Name string `typ:",required"`
+	}
+	src := map[string]interface{}{"name": "bob"}
+	var actual required
+	if err := Decode(src, &actual); err != nil || actual.Name != "bob" {
+		t.Errorf("Decode(%v) failed, expected (expected == actual) %q == %q, error %v", src, "bob", actual.Name, err)
+	}
+}
+
+func TestDecodeUnexportedEmbedded(t *testing.T) {
+	type embedded struct {
+		*decodeMeta
+		Name string `typ:"name"`
+	}
+	src := map[string]interface{}{"source": "api", "name": "bob"}
+	var actual embedded
+	if err := Decode(src, &actual); err != nil || actual.Name != "bob" || actual.decodeMeta != nil {
+		t.Errorf("Decode(%v) failed, expected nil embedded pointer & name %q instead of %+v, error %v", src, "bob", actual, err)
+	}
+	actual = embedded{decodeMeta: &decodeMeta{}}
+	if err := Decode(src, &actual); err != nil || actual.Source != "api" {
+		t.Errorf("Decode(%v) failed, expected source %q instead of %q, error %v", src, "api", actual.Source, err)
+	}
+}
+
+func TestDecodeInvalidArgument(t *testing.T) {
+	var actual decodeUser
+	for _, dst := range []interface{}{nil, actual, (*decodeUser)(nil)} {
+		if err := Decode(map[string]interface{}{}, dst); err != ErrInvalidArgument {
+			t.Errorf("Decode(%T) failed, expected error %v instead of %v", dst, ErrInvalidArgument, err)
+		}
+	}
+}
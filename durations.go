@@ -0,0 +1,246 @@
+package typ
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// isoDurationUnits are units of ISO 8601 duration designators, years and months aren't supported because of variable length
+var isoDurationUnits = map[bool]map[byte]time.Duration{
+	false: {'W': 7 * 24 * time.Hour, 'D': 24 * time.Hour},
+	true:  {'H': time.Hour, 'M': time.Minute, 'S': time.Second},
+}
+
+// DurationUnit set unit of numeric values for conversion to time.Duration. Nanoseconds are used by default
+func DurationUnit(unit time.Duration) Option {
+	return func(t *opts) error {
+		if unit <= 0 {
+			return ErrInvalidArgument
+		}
+		t.unit = unit
+		return nil
+	}
+}
+
+// Duration convert interface value to time.Duration.
+// Strings are parsed as Go durations ("1m30s"), ISO 8601 durations ("PT1M30S"), intervals ("1 day 02:30:00")
+// or numbers, numbers are multiplied by DurationUnit.
+// Returns value if type can safely converted, otherwise error & default value in result values
+func (t *Type) Duration(defaultValue ...time.Duration) DurationAccessor {
+	nv := &NullDuration{}
+	if nv.Error = t.err; t.err != nil {
+		return nv
+	}
+	nv = t.toDuration()
+	defaultDuration(nv, defaultValue...)
+	return nv
+}
+
+// Convert interface value to time.Duration.
+// Returns error if type can't safely converted
+func (t *Type) toDuration() *NullDuration {
+	nv := &NullDuration{}
+	if nt, ok := t.fromNumber(); ok {
+		return nt.toDuration()
+	}
+	if !t.rv.IsValid() {
+		nv.Error = t.newConversionError(reflect.Int64, nil)
+		return nv
+	}
+	var (
+		v   time.Duration
+		err error
+	)
+	switch {
+	case t.rv.Type() == reflect.TypeOf(v):
+		v = time.Duration(t.rv.Int())
+	case t.IsString(true):
+		v, err = stringDuration(t.rv.String(), t.opts.unit)
+	case t.IsInt(true):
+		v, err = intDuration(t.rv.Int(), t.opts.unit)
+	case t.IsUint(true):
+		if t.rv.Uint() > math.MaxInt64 {
+			err = ErrConvert
+			break
+		}
+		v, err = intDuration(int64(t.rv.Uint()), t.opts.unit)
+	case t.IsFloat(true):
+		v, err = floatDuration(t.rv.Float(), t.opts.unit)
+	default:
+		err = ErrConvert
+	}
+	if err != nil {
+		nv.Error = t.newConversionError(reflect.Int64, err)
+		return nv
+	}
+	nv.P = &v
+	return nv
+}
+
+// Parse string as Go duration, ISO 8601 duration, interval or number of units
+func stringDuration(from string, unit time.Duration) (time.Duration, error) {
+	from = strings.TrimSpace(from)
+	v, err := time.ParseDuration(from)
+	if err == nil {
+		return v, nil
+	}
+	if v, ok := isoDuration(from); ok {
+		return v, nil
+	}
+	if v, ok := intervalDuration(from); ok {
+		return v, nil
+	}
+	if i, intErr := strconv.ParseInt(from, 10, 64); intErr == nil {
+		return intDuration(i, unit)
+	}
+	if f, floatErr := strconv.ParseFloat(from, 64); floatErr == nil {
+		return floatDuration(f, unit)
+	}
+	return 0, err
+}
+
+// Convert int value of units to time.Duration
+func intDuration(from int64, unit time.Duration) (time.Duration, error) {
+	if unit == 0 {
+		unit = time.Nanosecond
+	}
+	if from > math.MaxInt64/int64(unit) || from < math.MinInt64/int64(unit) {
+		return 0, ErrConvert
+	}
+	return time.Duration(from) * unit, nil
+}
+
+// Convert float value of units to time.Duration, result is rounded to nanoseconds
+func floatDuration(from float64, unit time.Duration) (time.Duration, error) {
+	if unit == 0 {
+		unit = time.Nanosecond
+	}
+	v := math.Round(from * float64(unit))
+	if math.IsNaN(v) || v >= math.MaxInt64 || v < math.MinInt64 {
+		return 0, ErrConvert
+	}
+	return time.Duration(v), nil
+}
+
+// Parse ISO 8601 duration, e.g. "P1DT2H30M", "-PT1.5S". Years and months aren't supported
+func isoDuration(from string) (time.Duration, bool) {
+	var sign float64 = 1
+	if from != "" && (from[0] == '-' || from[0] == '+') {
+		if from[0] == '-' {
+			sign = -1
+		}
+		from = from[1:]
+	}
+	if len(from) < 2 || from[0] != 'P' {
+		return 0, false
+	}
+	var (
+		total  float64
+		inTime bool
+	)
+	for from = from[1:]; from != ""; {
+		if from[0] == 'T' {
+			if inTime || len(from) == 1 {
+				return 0, false
+			}
+			inTime, from = true, from[1:]
+			continue
+		}
+		i := strings.IndexFunc(from, func(r rune) bool {
+			return (r < '0' || r > '9') && r != '.' && r != ','
+		})
+		if i <= 0 {
+			return 0, false
+		}
+		unit, ok := isoDurationUnits[inTime][from[i]]
+		if !ok {
+			return 0, false
+		}
+		f, err := strconv.ParseFloat(strings.Replace(from[:i], ",", ".", 1), 64)
+		if err != nil {
+			return 0, false
+		}
+		total, from = total+f*float64(unit), from[i+1:]
+	}
+	v, err := floatDuration(sign*total, time.Nanosecond)
+	return v, err == nil
+}
+
+// Parse interval in format "[N day[s]] [-]HH:MM[:SS[.fraction]]", e.g. "1 day 02:30:00"
+func intervalDuration(from string) (time.Duration, bool) {
+	var (
+		total  float64
+		fields = strings.Fields(from)
+	)
+	if len(fields) == 0 || len(fields) > 3 {
+		return 0, false
+	}
+	if len(fields) >= 2 {
+		if fields[1] != "day" && fields[1] != "days" {
+			return 0, false
+		}
+		days, err := strconv.ParseInt(fields[0], 10, 64)
+		if err != nil {
+			return 0, false
+		}
+		total, fields = float64(days)*float64(24*time.Hour), fields[2:]
+	}
+	if len(fields) == 1 {
+		clock := fields[0]
+		var sign float64 = 1
+		if clock[0] == '-' || clock[0] == '+' {
+			if clock[0] == '-' {
+				sign = -1
+			}
+			clock = clock[1:]
+		}
+		parts := strings.Split(clock, ":")
+		if len(parts) < 2 || len(parts) > 3 {
+			return 0, false
+		}
+		var v float64
+		for i, part := range parts {
+			if part == "" || part[0] == '-' || part[0] == '+' {
+				return 0, false
+			}
+			var (
+				f   float64
+				err error
+			)
+			if i < 2 {
+				var n int64
+				n, err = strconv.ParseInt(part, 10, 64)
+				f = float64(n)
+			} else {
+				f, err = strconv.ParseFloat(part, 64)
+			}
+			if err != nil || (i > 0 && f >= 60) {
+				return 0, false
+			}
+			v = v*60 + f
+		}
+		if len(parts) == 2 {
+			v *= 60
+		}
+		total += sign * v * float64(time.Second)
+	}
+	v, err := floatDuration(total, time.Nanosecond)
+	return v, err == nil
+}
+
+// Set default duration value if duration isn't valid
+func defaultDuration(nv *NullDuration, defaultValue ...time.Duration) bool {
+	if len(defaultValue) > 1 {
+		nv.Error = ErrDefaultValue
+		return true
+	}
+	if !nv.Valid() && len(defaultValue) > 0 {
+		v := defaultValue[0]
+		nv.P = &v
+		return true
+	}
+	return false
+}
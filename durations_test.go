@@ -0,0 +1,73 @@
+package typ
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestDuration(t *testing.T) {
+	testData := []struct {
+		value    interface{}
+		options  []Option
+		expected time.Duration
+		err      error
+	}{
+		{"1m30s", nil, 90 * time.Second, nil},
+		{" -1.5h ", nil, -90 * time.Minute, nil},
+		{"0", nil, 0, nil},
+		{"PT1M30S", nil, 90 * time.Second, nil},
+		{"P1DT2H", nil, 26 * time.Hour, nil},
+		{"P2W", nil, 14 * 24 * time.Hour, nil},
+		{"PT0.5S", nil, 500 * time.Millisecond, nil},
+		{"PT0,25S", nil, 250 * time.Millisecond, nil},
+		{"-PT1H", nil, -time.Hour, nil},
+		{"P1Y", nil, 0, ErrConvert},
+		{"P1M", nil, 0, ErrConvert},
+		{"PT", nil, 0, ErrConvert},
+		{"P", nil, 0, ErrConvert},
+		{"PT1H1D", nil, 0, ErrConvert},
+		{"01:30:00", nil, 90 * time.Minute, nil},
+		{"01:30", nil, 90 * time.Minute, nil},
+		{"-00:00:01.5", nil, -1500 * time.Millisecond, nil},
+		{"1 day 02:30:00", nil, 26*time.Hour + 30*time.Minute, nil},
+		{"-1 days -01:00:00", nil, -25 * time.Hour, nil},
+		{"3 days", nil, 72 * time.Hour, nil},
+		{"01:60:00", nil, 0, ErrConvert},
+		{"1 week", nil, 0, ErrConvert},
+		{"90", nil, 90, nil},
+		{"90", []Option{DurationUnit(time.Second)}, 90 * time.Second, nil},
+		{"1.5", []Option{DurationUnit(time.Minute)}, 90 * time.Second, nil},
+		{"invalid", nil, 0, ErrConvert},
+		{"", nil, 0, ErrConvert},
+		{90, []Option{DurationUnit(time.Second)}, 90 * time.Second, nil},
+		{int8(-2), []Option{DurationUnit(time.Hour)}, -2 * time.Hour, nil},
+		{uint(3), []Option{DurationUnit(time.Millisecond)}, 3 * time.Millisecond, nil},
+		{uint64(math.MaxUint64), nil, 0, ErrConvert},
+		{math.MaxInt64, []Option{DurationUnit(time.Second)}, 0, ErrConvert},
+		{1.5, []Option{DurationUnit(time.Minute)}, 90 * time.Second, nil},
+		{1e300, nil, 0, ErrConvert},
+		{math.NaN(), nil, 0, ErrConvert},
+		{json.Number("90"), []Option{DurationUnit(time.Second)}, 90 * time.Second, nil},
+		{time.Minute, []Option{DurationUnit(time.Hour)}, time.Minute, nil},
+		{true, nil, 0, ErrConvert},
+		{nil, nil, 0, ErrConvert},
+		{1, []Option{DurationUnit(0)}, 0, ErrInvalidArgument},
+	}
+	for _, v := range testData {
+		nv := Of(v.value, v.options...).Duration()
+		if nv.V() != v.expected || !errors.Is(nv.Err(), v.err) {
+			t.Errorf("Of(%v).Duration() failed, expected (expected == actual) %v == %v, error %v == %v",
+				v.value, v.expected, nv.V(), v.err, nv.Err(),
+			)
+		}
+	}
+	if nv := Of("invalid").Duration(time.Second); nv.V() != time.Second || !errors.Is(nv.Err(), ErrConvert) {
+		t.Errorf("Of(invalid).Duration() failed, expected default value %v instead of %v, error %v", time.Second, nv.V(), nv.Err())
+	}
+	if nv := Of("invalid").Duration(time.Second, time.Minute); !errors.Is(nv.Err(), ErrDefaultValue) {
+		t.Errorf("Of(invalid).Duration() failed, expected error %v instead of %v", ErrDefaultValue, nv.Err())
+	}
+}
@@ -1,6 +1,10 @@
 package typ
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
 
 // ErrorConvert is returned when value can't safely convert
 type ErrorConvert error
@@ -20,6 +24,9 @@ type ErrorInvalidArgument error
 // ErrorUnexpectedValue is returned when unexpected value given on data
 type ErrorUnexpectedValue error
 
+// ErrorUnexportedField is returned when unexported struct field is requested
+type ErrorUnexportedField error
+
 var (
 	// ErrConvert is returned when value can't safely convert
 	ErrConvert = ErrorConvert(errors.New("value can't safely convert"))
@@ -29,8 +36,100 @@ var (
 	ErrOutOfBounds = ErrorOutOfBounds(errors.New("out of bounds on given data"))
 	// ErrUnexpectedValue is returned when unexpected value given on data
 	ErrUnexpectedValue = ErrorUnexpectedValue(errors.New("unexpected value given on data"))
+	// ErrUnexportedField is returned when unexported struct field is requested
+	ErrUnexportedField = ErrorUnexportedField(errors.New("unexported field given on data"))
 	// ErrInvalidArgument is returned when invalid argument is present
 	ErrInvalidArgument = ErrorInvalidArgument(errors.New("invalid argument"))
 	// ErrDefaultValue is returned when default value is ambiguous
 	ErrDefaultValue = ErrorInvalidArgument(errors.New("default value is ambiguous"))
 )
+
+// ConversionReason describes why value can't safely convert
+type ConversionReason int
+
+const (
+	// ReasonUnsupported is used when types can't convert to each other
+	ReasonUnsupported ConversionReason = iota
+	// ReasonOverflow is used when value out of range of target type
+	ReasonOverflow
+	// ReasonPrecision is used when value can't be represented by target type without precision loss
+	ReasonPrecision
+	// ReasonSyntax is used when string value can't be parsed
+	ReasonSyntax
+	// ReasonNegative is used when negative value converted to unsigned type
+	ReasonNegative
+	// ReasonImaginary is used when complex value with imaginary part converted to real type
+	ReasonImaginary
+)
+
+var conversionReasons = map[ConversionReason]string{
+	ReasonUnsupported: "unsupported conversion",
+	ReasonOverflow:    "overflow",
+	ReasonPrecision:   "precision loss",
+	ReasonSyntax:      "invalid syntax",
+	ReasonNegative:    "negative value to unsigned",
+	ReasonImaginary:   "imaginary part is lost",
+}
+
+// String returns description of reason
+func (r ConversionReason) String() string {
+	return conversionReasons[r]
+}
+
+// ConversionError is returned when value can't safely convert, it describes source value,
+// target kind, reason and path of value retrieved by Get. ToType is set when kind doesn't describe target, e.g. *big.Int.
+// ConversionError is ErrConvert for errors.Is
+type ConversionError struct {
+	Value  interface{}
+	From   reflect.Kind
+	To     reflect.Kind
+	ToType reflect.Type
+	Reason ConversionReason
+	Path   string
+	Err    error
+}
+
+// Error returns description of conversion failure
+func (e *ConversionError) Error() string {
+	var from, to interface{} = e.From, e.To
+	if e.Value != nil && (e.From == reflect.Ptr || e.From == reflect.Struct) {
+		from = reflect.TypeOf(e.Value)
+	}
+	if e.ToType != nil {
+		to = e.ToType
+	}
+	msg := fmt.Sprintf("can't convert %v (%v) to %v", e.Value, from, to)
+	if e.Path != "" {
+		msg += " at " + e.Path
+	}
+	msg += ": " + e.Reason.String()
+	if e.Err != nil && e.Err != ErrConvert {
+		msg += ": " + e.Err.Error()
+	}
+	return msg
+}
+
+// Unwrap returns underlying error
+func (e *ConversionError) Unwrap() error {
+	return e.Err
+}
+
+// Is determine whether a target is ErrConvert
+func (e *ConversionError) Is(target error) bool {
+	return target == ErrConvert
+}
+
+// Returns conversion error of value to kind, reason is detected by value
+func newConversionError(value interface{}, to reflect.Kind, err error) *ConversionError {
+	rv := reflect.ValueOf(value)
+	if err == nil {
+		err = ErrConvert
+	}
+	return &ConversionError{
+		Value:  value,
+		From:   rv.Kind(),
+		To:     to,
+		Reason: conversionReason(rv, to, err),
+		Err:    err,
+	}
+}
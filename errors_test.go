@@ -0,0 +1,79 @@
+package typ
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestConversionError(t *testing.T) {
+	testData := []struct {
+		err    error
+		from   reflect.Kind
+		to     reflect.Kind
+		reason ConversionReason
+		path   string
+	}{
+		{Of(300).Int8().Err(), reflect.Int, reflect.Int8, ReasonOverflow, ""},
+		{Of(uint64(MaxUint64)).Int64().Err(), reflect.Uint64, reflect.Int64, ReasonOverflow, ""},
+		{Of(1.5).Int().Err(), reflect.Float64, reflect.Int, ReasonPrecision, ""},
+		{Of(float64(1<<53 + 1<<1)).Float32().Err(), reflect.Float64, reflect.Float32, ReasonPrecision, ""},
+		{Of(math.MaxFloat64).Float32().Err(), reflect.Float64, reflect.Float32, ReasonOverflow, ""},
+		{Of(1e300).Int64().Err(), reflect.Float64, reflect.Int64, ReasonOverflow, ""},
+		{Of(-1).Uint().Err(), reflect.Int, reflect.Uint, ReasonNegative, ""},
+		{Of(-1.5).Uint8().Err(), reflect.Float64, reflect.Uint8, ReasonNegative, ""},
+		{Of(complex(1, 1)).Float().Err(), reflect.Complex128, reflect.Float64, ReasonImaginary, ""},
+		{Of("1a").Int().Err(), reflect.String, reflect.Int, ReasonSyntax, ""},
+		{Of("300").Uint8().Err(), reflect.String, reflect.Uint8, ReasonOverflow, ""},
+		{Of([]int{}).Int().Err(), reflect.Slice, reflect.Int, ReasonUnsupported, ""},
+		{Of(map[string]interface{}{"a": []interface{}{300}}).Get("a", 0).Int8().Err(), reflect.Int, reflect.Int8, ReasonOverflow, "a.0"},
+		{Of(map[string]interface{}{"a": []interface{}{-1}}).Path("a.0").Uint().Err(), reflect.Int, reflect.Uint, ReasonNegative, "a.0"},
+		{CompilePath("a").From(map[string]float64{"a": 0.5}).Int().Err(), reflect.Float64, reflect.Int, ReasonPrecision, "a"},
+		{Int8(300).Err(), reflect.Int64, reflect.Int8, ReasonOverflow, ""},
+		{FloatUint(-1).Err(), reflect.Float64, reflect.Uint, ReasonNegative, ""},
+		{StringInt32("x").Err(), reflect.String, reflect.Int32, ReasonSyntax, ""},
+		{Of(big.NewRat(1, 3)).Float().Err(), reflect.Ptr, reflect.Float64, ReasonPrecision, ""},
+		{Of(big.NewInt(300)).Int8().Err(), reflect.Ptr, reflect.Int8, ReasonOverflow, ""},
+		{Of(new(big.Int).SetUint64(MaxUint64)).Int64().Err(), reflect.Ptr, reflect.Int64, ReasonOverflow, ""},
+		{Of(new(big.Int).Lsh(big.NewInt(1), 1100)).Float32().Err(), reflect.Ptr, reflect.Float32, ReasonOverflow, ""},
+		{Of(big.NewFloat(-1)).Uint().Err(), reflect.Ptr, reflect.Uint, ReasonNegative, ""},
+		{Of(big.NewFloat(7.5)).Int().Err(), reflect.Ptr, reflect.Int, ReasonPrecision, ""},
+		{Of(NewDecimal(15, 1)).Int().Err(), reflect.Struct, reflect.Int, ReasonPrecision, ""},
+		{Of(2.5).BigInt().Err(), reflect.Float64, reflect.Struct, ReasonPrecision, ""},
+		{Of(math.Inf(1)).BigInt().Err(), reflect.Float64, reflect.Struct, ReasonOverflow, ""},
+		{Of(math.Inf(-1)).BigRat().Err(), reflect.Float64, reflect.Struct, ReasonOverflow, ""},
+		{Of(math.NaN()).BigFloat(0).Err(), reflect.Float64, reflect.Struct, ReasonPrecision, ""},
+		{Of("x").BigInt().Err(), reflect.String, reflect.Struct, ReasonSyntax, ""},
+	}
+	for i, v := range testData {
+		var ce *ConversionError
+		if !errors.As(v.err, &ce) || !errors.Is(v.err, ErrConvert) {
+			t.Errorf("#%d conversion failed, expected *ConversionError instead of %T(%[2]v)", i, v.err)
+			continue
+		}
+		if ce.From != v.from || ce.To != v.to || ce.Reason != v.reason || ce.Path != v.path {
+			t.Errorf("#%d conversion failed, expected (expected == actual) from %v == %v, to %v == %v, reason %v == %v, path %q == %q",
+				i, v.from, ce.From, v.to, ce.To, v.reason, ce.Reason, v.path, ce.Path,
+			)
+		}
+	}
+	err := Of("1a").Int().Err()
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("Of(\"1a\").Int() failed, expected underlying error %v instead of %v", strconv.ErrSyntax, err)
+	}
+	err = Of(map[string]interface{}{"a": 300}).Get("a").Int8().Err()
+	if expected := "can't convert 300 (int) to int8 at a: overflow"; err == nil || err.Error() != expected {
+		t.Errorf("Of(data).Get(\"a\").Int8() failed, expected error %q instead of %v", expected, err)
+	}
+	err = Of(big.NewRat(1, 3)).Float().Err()
+	if expected := "can't convert 1/3 (*big.Rat) to float64: precision loss"; err == nil || err.Error() != expected {
+		t.Errorf("Of(big.Rat(1/3)).Float() failed, expected error %q instead of %v", expected, err)
+	}
+	err = Of(2.5).BigInt().Err()
+	if expected := "can't convert 2.5 (float64) to *big.Int: precision loss"; err == nil || err.Error() != expected {
+		t.Errorf("Of(2.5).BigInt() failed, expected error %q instead of %v", expected, err)
+	}
+}
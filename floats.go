@@ -44,20 +44,25 @@ func (t *Type) Float(defaultValue ...float64) FloatAccessor {
 func (t *Type) toFloat(typeTo reflect.Kind) FloatAccessor {
 	nv := &NullFloat{}
 	if !t.rv.IsValid() || !isFloat(typeTo) {
-		nv.Error = ErrConvert
+		nv.Error = t.newConversionError(typeTo, nil)
 		return nv
 	}
+	if nt, ok := t.fromNumber(); ok {
+		return nt.toFloat(typeTo)
+	}
 	switch {
 	case t.IsString(true):
-		value, err := strconv.ParseFloat(t.rv.String(), bitSizeMap[typeTo])
+		value, err := t.parseFloat(bitSizeMap[typeTo])
 		nv.P = &value
-		nv.Error = err
+		if err != nil {
+			nv.Error = t.newConversionError(typeTo, err)
+		}
 		return nv
 	case t.IsFloat(true):
 		floatValue := t.rv.Float()
 		nv.P = &floatValue
 		if !isSafeFloat(floatValue, bitSizeMap[typeTo]) {
-			nv.Error = ErrConvert
+			nv.Error = t.newConversionError(typeTo, nil)
 		}
 		return nv
 	case t.IsInt(true):
@@ -65,7 +70,7 @@ func (t *Type) toFloat(typeTo reflect.Kind) FloatAccessor {
 		v := float64(intValue)
 		nv.P = &v
 		if !isSafeIntToFloat(intValue, bitSizeMap[typeTo]) {
-			nv.Error = ErrConvert
+			nv.Error = t.newConversionError(typeTo, nil)
 		}
 		return nv
 	case t.IsUint(true):
@@ -73,7 +78,7 @@ func (t *Type) toFloat(typeTo reflect.Kind) FloatAccessor {
 		v := float64(uintValue)
 		nv.P = &v
 		if !isSafeUintToFloat(uintValue, bitSizeMap[typeTo]) {
-			nv.Error = ErrConvert
+			nv.Error = t.newConversionError(typeTo, nil)
 		}
 		return nv
 	case t.IsComplex(true):
@@ -81,7 +86,7 @@ func (t *Type) toFloat(typeTo reflect.Kind) FloatAccessor {
 		v := float64(real(complexValue))
 		nv.P = &v
 		if !isSafeComplexToFloat(complexValue, bitSizeMap[typeTo]) {
-			nv.Error = ErrConvert
+			nv.Error = t.newConversionError(typeTo, nil)
 		}
 		return nv
 	case t.IsBool(true):
@@ -94,7 +99,7 @@ func (t *Type) toFloat(typeTo reflect.Kind) FloatAccessor {
 		}
 		return nv
 	}
-	nv.Error = ErrConvert
+	nv.Error = t.newConversionError(typeTo, nil)
 	return nv
 }
 
@@ -103,7 +108,7 @@ func (t *Type) toFloat(typeTo reflect.Kind) FloatAccessor {
 func IntFloat32(from int64, defaultValue ...float32) Float32Accessor {
 	nv := &NullFloat32{}
 	if safe := isSafeIntToFloat(from, 32); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Float32, nil)
 		if defaultFloat32(nv, defaultValue...) {
 			return nv
 		}
@@ -118,7 +123,7 @@ func IntFloat32(from int64, defaultValue ...float32) Float32Accessor {
 func IntFloat(from int64, defaultValue ...float64) FloatAccessor {
 	nv := &NullFloat{}
 	if safe := isSafeIntToFloat(from, 64); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Float64, nil)
 		if defaultFloat(nv, defaultValue...) {
 			return nv
 		}
@@ -133,7 +138,7 @@ func IntFloat(from int64, defaultValue ...float64) FloatAccessor {
 func UintFloat32(from uint64, defaultValue ...float32) Float32Accessor {
 	nv := &NullFloat32{}
 	if safe := isSafeUintToFloat(from, 32); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Float32, nil)
 		if defaultFloat32(nv, defaultValue...) {
 			return nv
 		}
@@ -148,7 +153,7 @@ func UintFloat32(from uint64, defaultValue ...float32) Float32Accessor {
 func UintFloat(from uint64, defaultValue ...float64) FloatAccessor {
 	nv := &NullFloat{}
 	if safe := isSafeUintToFloat(from, 64); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Float64, nil)
 		if defaultFloat(nv, defaultValue...) {
 			return nv
 		}
@@ -163,7 +168,7 @@ func UintFloat(from uint64, defaultValue ...float64) FloatAccessor {
 func Float32(from float64, defaultValue ...float32) Float32Accessor {
 	nv := &NullFloat32{}
 	if safe := isSafeFloat(from, 32); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Float32, nil)
 		if defaultFloat32(nv, defaultValue...) {
 			return nv
 		}
@@ -178,7 +183,7 @@ func Float32(from float64, defaultValue ...float32) Float32Accessor {
 func Complex64Float32(from complex64, defaultValue ...float32) Float32Accessor {
 	nv := &NullFloat32{}
 	if safe := isSafeComplexToFloat(complex128(from), 32); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Float32, nil)
 		if defaultFloat32(nv, defaultValue...) {
 			return nv
 		}
@@ -193,7 +198,7 @@ func Complex64Float32(from complex64, defaultValue ...float32) Float32Accessor {
 func Complex64Float64(from complex64, defaultValue ...float64) FloatAccessor {
 	nv := &NullFloat{}
 	if safe := isSafeComplexToFloat(complex128(from), 64); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Float64, nil)
 		if defaultFloat(nv, defaultValue...) {
 			return nv
 		}
@@ -208,7 +213,7 @@ func Complex64Float64(from complex64, defaultValue ...float64) FloatAccessor {
 func ComplexFloat32(from complex128, defaultValue ...float32) Float32Accessor {
 	nv := &NullFloat32{}
 	if safe := isSafeComplexToFloat(from, 32); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Float32, nil)
 		if defaultFloat32(nv, defaultValue...) {
 			return nv
 		}
@@ -223,7 +228,7 @@ func ComplexFloat32(from complex128, defaultValue ...float32) Float32Accessor {
 func ComplexFloat64(from complex128, defaultValue ...float64) FloatAccessor {
 	nv := &NullFloat{}
 	if safe := isSafeComplexToFloat(from, 64); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Float64, nil)
 		if defaultFloat(nv, defaultValue...) {
 			return nv
 		}
@@ -238,7 +243,9 @@ func ComplexFloat64(from complex128, defaultValue ...float64) FloatAccessor {
 func StringFloat32(from string, defaultValue ...float32) Float32Accessor {
 	nv := &NullFloat32{}
 	pv, err := strconv.ParseFloat(from, 32)
-	nv.Error = err
+	if err != nil {
+		nv.Error = newConversionError(from, reflect.Float32, err)
+	}
 	if defaultFloat32(nv, defaultValue...) {
 		return nv
 	}
@@ -252,7 +259,9 @@ func StringFloat32(from string, defaultValue ...float32) Float32Accessor {
 func StringFloat(from string, defaultValue ...float64) FloatAccessor {
 	nv := &NullFloat{}
 	pv, err := strconv.ParseFloat(from, 64)
-	nv.Error = err
+	if err != nil {
+		nv.Error = newConversionError(from, reflect.Float64, err)
+	}
 	if defaultFloat(nv, defaultValue...) {
 		return nv
 	}
@@ -0,0 +1,108 @@
+package typ
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strconv"
+)
+
+// Primitive is a constraint that permits any built-in primitive type supported by conversion
+type Primitive interface {
+	~bool |
+		~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64 |
+		~complex64 | ~complex128 |
+		~string
+}
+
+// To convert interface value to any primitive type T.
+// Conversion follows the same safe rules as the typed accessors, e.g. To[int8](v) behaves like Of(v).Int8().
+// Returns value if type can safely converted, otherwise error in result values
+func To[T Primitive](value interface{}, options ...Option) *Null[T] {
+	return toGeneric[T](Of(value, options...))
+}
+
+// StringNumber convert string to any integer or float type T, options configure parsing,
+// e.g. StringNumber[int](" 1,234 ", TrimSpace(), DigitSeparator(',')).
+// Returns value if type can safely converted, otherwise error in result values
+func StringNumber[T Real](from string, options ...Option) *Null[T] {
+	return To[T](from, options...)
+}
+
+// Convert Type to any primitive type T
+func toGeneric[T Primitive](t *Type) *Null[T] {
+	nv := &Null[T]{}
+	if nv.Error = t.err; t.err != nil {
+		return nv
+	}
+	rt := primitiveType[T]()
+	if isString(rt.Kind()) {
+		valueTo := t.String()
+		if nv.Error = valueTo.Err(); !valueTo.Present() {
+			return nv
+		}
+		v := reflect.ValueOf(valueTo.V()).Convert(rt).Interface().(T)
+		nv.P = &v
+		return nv
+	}
+	valueTo := t.to(rt.Kind())
+	nv.Error = valueTo.Err()
+	if t.opts.lossy() && (isInt(rt.Kind()) || isUint(rt.Kind())) {
+		_, nv.Lost, _ = t.toLossy(rt.Kind())
+	}
+	rv := reflect.ValueOf(valueTo.V())
+	if !rv.IsValid() {
+		rv = reflect.Zero(rt)
+	}
+	v := rv.Convert(rt).Interface().(T)
+	nv.P = &v
+	return nv
+}
+
+// Returns reflect type of primitive type T
+func primitiveType[T Primitive]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+// Returns the narrowest exact Go value for a JSON number: int64, uint64 or float64
+func jsonNumberValue(n json.Number) (interface{}, error) {
+	if v, err := strconv.ParseInt(string(n), 10, 64); err == nil {
+		return v, nil
+	}
+	if v, err := strconv.ParseUint(string(n), 10, 64); err == nil {
+		return v, nil
+	}
+	return strconv.ParseFloat(string(n), 64)
+}
+
+// Decode JSON number into the narrowest exact Go value, numbers in quoted strings are accepted if quoted is true.
+// Returns nil if JSON value is null
+func decodeJSONNumber(b []byte, quoted bool) (interface{}, error) {
+	var uv interface{}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&uv); err != nil {
+		return nil, err
+	}
+	var number json.Number
+	switch v := uv.(type) {
+	case nil:
+		return nil, nil
+	case json.Number:
+		number = v
+	case string:
+		if !quoted {
+			return nil, ErrConvert
+		}
+		number = json.Number(v)
+	default:
+		return nil, ErrConvert
+	}
+	value, err := jsonNumberValue(number)
+	if err != nil {
+		return nil, ErrConvert
+	}
+	return value, nil
+}
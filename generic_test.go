@@ -0,0 +1,74 @@
+package typ
+
+import (
+	"reflect"
+	"testing"
+)
+
+var genericTestData = []interface{}{
+	nil, true, false, "", "42", "-42", "3.5", "1+2i", "abc",
+	0, 42, -42, MaxInt8, MinInt8, MaxInt16, MaxInt32, MinInt64, MaxInt64,
+	uint8(42), MaxUint8, MaxUint16, MaxUint32, MaxUint64,
+	float32(3.5), float32(42), MaxFloat32, 3.1415926535, 42.0, -42.0, MaxSafeIntFloat64,
+	complex64(42), complex(1, 2), complex(42, 0),
+}
+
+func testGenericEquals(t *testing.T, name string, generic, accessor Common, gv, av interface{}) {
+	if generic.Present() != accessor.Present() || !reflect.DeepEqual(generic.Err(), accessor.Err()) || gv != av {
+		t.Errorf("%s, %s", name, errNull{
+			av, accessor.Valid(), accessor.Err(),
+			gv, generic.Valid(), generic.Err(),
+		})
+	}
+}
+
+func TestTo(t *testing.T) {
+	for _, v := range genericTestData {
+		nInt, aInt := To[int](v), Of(v).Int()
+		testGenericEquals(t, "To[int]", nInt, aInt, nInt.V(), aInt.V())
+		nInt8, aInt8 := To[int8](v), Of(v).Int8()
+		testGenericEquals(t, "To[int8]", nInt8, aInt8, nInt8.V(), aInt8.V())
+		nInt32, aInt32 := To[int32](v), Of(v).Int32()
+		testGenericEquals(t, "To[int32]", nInt32, aInt32, nInt32.V(), aInt32.V())
+		nUint16, aUint16 := To[uint16](v), Of(v).Uint16()
+		testGenericEquals(t, "To[uint16]", nUint16, aUint16, nUint16.V(), aUint16.V())
+		nUint64, aUint64 := To[uint64](v), Of(v).Uint64()
+		testGenericEquals(t, "To[uint64]", nUint64, aUint64, nUint64.V(), aUint64.V())
+		nFloat32, aFloat32 := To[float32](v), Of(v).Float32()
+		testGenericEquals(t, "To[float32]", nFloat32, aFloat32, nFloat32.V(), aFloat32.V())
+		nFloat, aFloat := To[float64](v), Of(v).Float()
+		testGenericEquals(t, "To[float64]", nFloat, aFloat, nFloat.V(), aFloat.V())
+		nComplex, aComplex := To[complex128](v), Of(v).Complex()
+		testGenericEquals(t, "To[complex128]", nComplex, aComplex, nComplex.V(), aComplex.V())
+		nBool, aBool := To[bool](v), Of(v).Bool()
+		testGenericEquals(t, "To[bool]", nBool, aBool, nBool.V(), aBool.V())
+		nString, aString := To[string](v), Of(v).String()
+		testGenericEquals(t, "To[string]", nString, aString, nString.V(), aString.V())
+	}
+}
+
+func TestToNamedType(t *testing.T) {
+	type ID int64
+	nv := To[ID]("42")
+	if !nv.Valid() || nv.V() != ID(42) {
+		t.Errorf("To[ID](\"42\"), %s", errNull{ID(42), true, nil, nv.V(), nv.Valid(), nv.Err()})
+	}
+}
+
+func TestToOptions(t *testing.T) {
+	nv := To[string](3.1415926535, FmtByte('g'), Precision(4))
+	if nv.V() != "3.142" {
+		t.Errorf("To[string](3.1415926535, FmtByte('g'), Precision(4)), %s", errNull{"3.142", true, nil, nv.V(), nv.Valid(), nv.Err()})
+	}
+	nv = To[string](1, FmtByte('z'))
+	if nv.Err() != ErrFmtByteInvalid || nv.Present() {
+		t.Errorf("To[string](1, FmtByte('z')), %s", errNull{nil, false, ErrFmtByteInvalid, nv.V(), nv.Valid(), nv.Err()})
+	}
+}
+
+// BenchmarkTo-8   	 2393715	       481 ns/op
+func BenchmarkTo(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		To[int32](42.0)
+	}
+}
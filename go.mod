@@ -1,3 +1,3 @@
 module github.com/gurukami/typ/v2
 
-go 1.12
+go 1.18
@@ -0,0 +1,191 @@
+package typ
+
+import (
+	"math/big"
+	"reflect"
+	"strings"
+)
+
+// CompactScale is a set of suffixes for compact notation of numbers
+type CompactScale int
+
+const (
+	// CompactShort are short scale suffixes: K, M, B, T
+	CompactShort CompactScale = iota
+	// CompactSI are SI prefixes: k, M, G, T, P, E
+	CompactSI
+)
+
+var (
+	compactSuffixes = map[CompactScale][]string{
+		CompactShort: {"", "K", "M", "B", "T"},
+		CompactSI:    {"", "k", "M", "G", "T", "P", "E"},
+	}
+	numberWords = []string{
+		"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten",
+		"eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen",
+	}
+	tensWords  = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+	scaleWords = []string{
+		"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion",
+		"sextillion", "septillion", "octillion", "nonillion", "decillion",
+	}
+	bigThousand = big.NewInt(1000)
+)
+
+// CompactSuffixes set suffixes for compact notation of numbers, CompactShort is used by default
+func CompactSuffixes(scale CompactScale) Option {
+	return func(t *opts) error {
+		if _, ok := compactSuffixes[scale]; !ok {
+			return ErrInvalidArgument
+		}
+		t.compact = scale
+		return nil
+	}
+}
+
+// NumberWords convert integral numeric value to English words, e.g. 42 to "forty-two".
+// Words are English only, Locale option isn't applied to them.
+// Returns error if value isn't integral or it's too large for named scales
+func (t *Type) NumberWords() StringAccessor {
+	nv := &NullString{}
+	if nv.Error = t.err; t.err != nil {
+		return nv
+	}
+	i, err := t.humanInt()
+	if err != nil {
+		nv.Error = err
+		return nv
+	}
+	var groups []string
+	abs := new(big.Int).Abs(i)
+	for scale := 0; abs.Sign() > 0; scale++ {
+		if scale == len(scaleWords) {
+			nv.Error = t.newConversionError(reflect.String, nil)
+			return nv
+		}
+		var group big.Int
+		abs.QuoRem(abs, bigThousand, &group)
+		if group.Sign() == 0 {
+			continue
+		}
+		words := hundredWords(int(group.Int64()))
+		if scaleWords[scale] != "" {
+			words += " " + scaleWords[scale]
+		}
+		groups = append([]string{words}, groups...)
+	}
+	v := numberWords[0]
+	if len(groups) > 0 {
+		v = strings.Join(groups, " ")
+	}
+	if i.Sign() < 0 {
+		v = "minus " + v
+	}
+	nv.P = &v
+	return nv
+}
+
+// Ordinal convert integral numeric value to English ordinal number, e.g. 3 to "3rd".
+// Digits are formatted by Locale option if it's set
+func (t *Type) Ordinal() StringAccessor {
+	nv := &NullString{}
+	if nv.Error = t.err; t.err != nil {
+		return nv
+	}
+	i, err := t.humanInt()
+	if err != nil {
+		nv.Error = err
+		return nv
+	}
+	suffix := "th"
+	if n := new(big.Int).Abs(i); new(big.Int).Rem(n, big.NewInt(100)).Int64()/10 != 1 {
+		switch new(big.Int).Rem(n, big.NewInt(10)).Int64() {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+	v := i.String()
+	if t.opts.locale != nil {
+		v = t.opts.locale.format(v)
+	}
+	v += suffix
+	nv.P = &v
+	return nv
+}
+
+// Compact convert numeric value to compact notation with suffix, e.g. 1234567 to "1.2M".
+// Precision option sets maximum count of fractional digits, 1 is used if it isn't set or negative, trailing zeros are removed.
+// Suffixes are set by CompactSuffixes option, number is formatted by Locale option if it's set
+func (t *Type) Compact() StringAccessor {
+	nv := &NullString{}
+	if nv.Error = t.err; t.err != nil {
+		return nv
+	}
+	r := t.toBigRat()
+	if r.Err() != nil {
+		nv.Error = r.Err()
+		return nv
+	}
+	prec := 1
+	if t.opts.precisionSet && *t.opts.precision >= 0 {
+		prec = *t.opts.precision
+	}
+	// Value is scaled exactly, so it isn't limited by range of float64
+	f, thousand := new(big.Rat).Abs(r.V()), new(big.Rat).SetInt(bigThousand)
+	suffixes, unit := compactSuffixes[t.opts.compact], 0
+	for unit < len(suffixes)-1 && f.Cmp(thousand) >= 0 {
+		f, unit = f.Quo(f, thousand), unit+1
+	}
+	v := f.FloatString(prec)
+	if rounded, _ := new(big.Rat).SetString(v); rounded.Cmp(thousand) >= 0 && unit < len(suffixes)-1 {
+		f, unit = f.Quo(f, thousand), unit+1
+		v = f.FloatString(prec)
+	}
+	if strings.Contains(v, ".") {
+		v = strings.TrimRight(strings.TrimRight(v, "0"), ".")
+	}
+	if r.V().Sign() < 0 && v != "0" {
+		v = "-" + v
+	}
+	if t.opts.locale != nil {
+		v = t.opts.locale.format(v)
+	}
+	v += suffixes[unit]
+	nv.P = &v
+	return nv
+}
+
+// Returns integral value of numeric type for humanization
+func (t *Type) humanInt() (*big.Int, error) {
+	r := t.toBigRat()
+	if r.Err() != nil {
+		return nil, r.Err()
+	}
+	if !r.V().IsInt() {
+		return nil, t.newConversionError(reflect.String, nil)
+	}
+	return r.V().Num(), nil
+}
+
+// Returns English words of number in range [1, 999]
+func hundredWords(n int) string {
+	var words []string
+	if n >= 100 {
+		words, n = append(words, numberWords[n/100], "hundred"), n%100
+	}
+	switch {
+	case n == 0:
+	case n < 20:
+		words = append(words, numberWords[n])
+	case n%10 == 0:
+		words = append(words, tensWords[n/10])
+	default:
+		words = append(words, tensWords[n/10]+"-"+numberWords[n%10])
+	}
+	return strings.Join(words, " ")
+}
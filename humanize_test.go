@@ -0,0 +1,155 @@
+package typ
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNumberWords(t *testing.T) {
+	huge, _ := new(big.Int).SetString("1000000000000000000000000000000000000", 10)
+	testData := []struct {
+		value    interface{}
+		expected string
+		err      error
+	}{
+		{0, "zero", nil},
+		{7, "seven", nil},
+		{13, "thirteen", nil},
+		{42, "forty-two", nil},
+		{int8(-90), "minus ninety", nil},
+		{uint16(100), "one hundred", nil},
+		{305, "three hundred five", nil},
+		{1000, "one thousand", nil},
+		{1000001, "one million one", nil},
+		{1234567, "one million two hundred thirty-four thousand five hundred sixty-seven", nil},
+		{uint64(MaxUint64), "eighteen quintillion four hundred forty-six quadrillion seven hundred forty-four trillion " +
+			"seventy-three billion seven hundred nine million five hundred fifty-one thousand six hundred fifteen", nil},
+		{21.0, "twenty-one", nil},
+		{complex(12, 0), "twelve", nil},
+		{"99", "ninety-nine", nil},
+		{json.Number("11"), "eleven", nil},
+		{big.NewInt(1e15), "one quadrillion", nil},
+		{huge, "", ErrConvert},
+		{2.5, "", ErrConvert},
+		{complex(1, 1), "", ErrConvert},
+		{math.NaN(), "", ErrConvert},
+		{true, "", ErrConvert},
+	}
+	for _, v := range testData {
+		nv := Of(v.value).NumberWords()
+		if nv.V() != v.expected || !errors.Is(nv.Err(), v.err) {
+			t.Errorf("Of(%v).NumberWords() failed, expected (expected == actual) %q == %q, error %v == %v",
+				v.value, v.expected, nv.V(), v.err, nv.Err(),
+			)
+		}
+	}
+}
+
+func TestOrdinal(t *testing.T) {
+	testData := []struct {
+		value    interface{}
+		options  []Option
+		expected string
+		err      error
+	}{
+		{0, nil, "0th", nil},
+		{1, nil, "1st", nil},
+		{2, nil, "2nd", nil},
+		{3, nil, "3rd", nil},
+		{4, nil, "4th", nil},
+		{11, nil, "11th", nil},
+		{12, nil, "12th", nil},
+		{13, nil, "13th", nil},
+		{21, nil, "21st", nil},
+		{102, nil, "102nd", nil},
+		{111, nil, "111th", nil},
+		{-3, nil, "-3rd", nil},
+		{uint8(23), nil, "23rd", nil},
+		{float32(101), nil, "101st", nil},
+		{1001, []Option{Locale("en")}, "1,001st", nil},
+		{1.5, nil, "", ErrConvert},
+		{"abc", nil, "", ErrConvert},
+	}
+	for _, v := range testData {
+		nv := Of(v.value, v.options...).Ordinal()
+		if nv.V() != v.expected || !errors.Is(nv.Err(), v.err) {
+			t.Errorf("Of(%v).Ordinal() failed, expected (expected == actual) %q == %q, error %v == %v",
+				v.value, v.expected, nv.V(), v.err, nv.Err(),
+			)
+		}
+	}
+}
+
+func TestCompact(t *testing.T) {
+	testData := []struct {
+		value    interface{}
+		options  []Option
+		expected string
+		err      error
+	}{
+		{0, nil, "0", nil},
+		{999, nil, "999", nil},
+		{1000, nil, "1K", nil},
+		{1234, nil, "1.2K", nil},
+		{1234567, nil, "1.2M", nil},
+		{-1234567, nil, "-1.2M", nil},
+		{999950, nil, "1M", nil},
+		{999949, nil, "999.9K", nil},
+		{uint64(3e9), nil, "3B", nil},
+		{2.5e12, nil, "2.5T", nil},
+		{2.5e15, nil, "2500T", nil},
+		{2.5e15, []Option{CompactSuffixes(CompactSI)}, "2.5P", nil},
+		{1234567, []Option{CompactSuffixes(CompactSI)}, "1.2M", nil},
+		{1500, []Option{CompactSuffixes(CompactSI)}, "1.5k", nil},
+		{1234567, []Option{Precision(3)}, "1.235M", nil},
+		{1234567, []Option{Precision(0)}, "1M", nil},
+		{1234567, []Option{Precision(-1)}, "1.2M", nil},
+		{1234567, []Option{Locale("de")}, "1,2M", nil},
+		{-1234567, []Option{Locale("sv")}, "−1,2M", nil},
+		{12.345, []Option{Precision(2)}, "12.35", nil},
+		{-0.01, nil, "0", nil},
+		{complex(1500, 0), nil, "1.5K", nil},
+		{"1500000", nil, "1.5M", nil},
+		{math.Inf(1), nil, "", ErrConvert},
+		{struct{}{}, nil, "", ErrConvert},
+		{1, []Option{CompactSuffixes(CompactScale(5))}, "", ErrInvalidArgument},
+	}
+	for _, v := range testData {
+		nv := Of(v.value, v.options...).Compact()
+		if nv.V() != v.expected || !errors.Is(nv.Err(), v.err) {
+			t.Errorf("Of(%v).Compact() failed, expected (expected == actual) %q == %q, error %v == %v",
+				v.value, v.expected, nv.V(), v.err, nv.Err(),
+			)
+		}
+	}
+}
+
+func TestCompactBeyondFloat(t *testing.T) {
+	value := new(big.Int).Exp(big.NewInt(10), big.NewInt(400), nil)
+	expected := "1" + strings.Repeat("0", 388) + "T"
+	nv := Of(value).Compact()
+	if nv.V() != expected || nv.Err() != nil {
+		t.Errorf("Of(1e400).Compact() failed, expected (expected == actual) %q == %q, error %v", expected, nv.V(), nv.Err())
+	}
+	nv = Of(new(big.Int).Neg(value), CompactSuffixes(CompactSI)).Compact()
+	if expected = "-1" + strings.Repeat("0", 382) + "E"; nv.V() != expected || nv.Err() != nil {
+		t.Errorf("Of(-1e400).Compact() failed, expected (expected == actual) %q == %q, error %v", expected, nv.V(), nv.Err())
+	}
+}
+
+func TestCompactError(t *testing.T) {
+	var ce *ConversionError
+	nv := Of(struct{}{}).Compact()
+	if !errors.As(nv.Err(), &ce) || ce.ToType != reflect.TypeOf(&big.Rat{}) {
+		t.Errorf("Of(struct{}{}).Compact() failed, expected conversion error to *big.Rat, actual %v", nv.Err())
+	}
+	nv = Of(struct{}{}).NumberWords()
+	if !errors.As(nv.Err(), &ce) || ce.ToType != reflect.TypeOf(&big.Rat{}) {
+		t.Errorf("Of(struct{}{}).NumberWords() failed, expected conversion error to *big.Rat, actual %v", nv.Err())
+	}
+}
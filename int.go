@@ -10,13 +10,25 @@ import (
 func (t *Type) toInt(typeTo reflect.Kind) Int64Accessor {
 	nv := &NullInt64{}
 	if !t.rv.IsValid() || !isInt(typeTo) {
-		nv.Error = ErrConvert
+		nv.Error = t.newConversionError(typeTo, nil)
 		return nv
 	}
+	if nt, ok := t.fromNumber(); ok {
+		return nt.toInt(typeTo)
+	}
+	if t.opts.lossy() {
+		if bits, lost, ok := t.toLossy(typeTo); ok {
+			v := int64(bits)
+			nv.P, nv.Lost = &v, lost
+			return nv
+		}
+	}
 	switch {
 	case t.IsString(true):
-		value, err := strconv.ParseInt(t.rv.String(), 0, bitSizeMap[typeTo])
-		nv.Error = err
+		value, err := t.parseInt(bitSizeMap[typeTo])
+		if err != nil {
+			nv.Error = t.newConversionError(typeTo, err)
+		}
 		v := value
 		nv.P = &v
 		return nv
@@ -25,7 +37,7 @@ func (t *Type) toInt(typeTo reflect.Kind) Int64Accessor {
 		v := intValue
 		nv.P = &v
 		if !isSafeInt(intValue, bitSizeMap[typeTo]) {
-			nv.Error = ErrConvert
+			nv.Error = t.newConversionError(typeTo, nil)
 		}
 		return nv
 	case t.IsUint(true):
@@ -33,7 +45,7 @@ func (t *Type) toInt(typeTo reflect.Kind) Int64Accessor {
 		v := int64(uintValue)
 		nv.P = &v
 		if !isSafeUintToInt(uintValue, bitSizeMap[typeTo]) {
-			nv.Error = ErrConvert
+			nv.Error = t.newConversionError(typeTo, nil)
 		}
 		return nv
 	case t.IsFloat(true):
@@ -41,7 +53,7 @@ func (t *Type) toInt(typeTo reflect.Kind) Int64Accessor {
 		v := int64(floatValue)
 		nv.P = &v
 		if !isSafeFloatToInt(floatValue, bitSizeMap[t.Kind()], bitSizeMap[typeTo]) {
-			nv.Error = ErrConvert
+			nv.Error = t.newConversionError(typeTo, nil)
 		}
 		return nv
 	case t.IsComplex(true):
@@ -49,7 +61,7 @@ func (t *Type) toInt(typeTo reflect.Kind) Int64Accessor {
 		v := int64(real(complexValue))
 		nv.P = &v
 		if !isSafeComplexToInt(complexValue, bitSizeMap[t.Kind()], bitSizeMap[typeTo]) {
-			nv.Error = ErrConvert
+			nv.Error = t.newConversionError(typeTo, nil)
 		}
 		return nv
 	case t.IsBool(true):
@@ -62,7 +74,7 @@ func (t *Type) toInt(typeTo reflect.Kind) Int64Accessor {
 		}
 		return nv
 	}
-	nv.Error = ErrConvert
+	nv.Error = t.newConversionError(typeTo, nil)
 	return nv
 }
 
@@ -74,7 +86,7 @@ func (t *Type) Int(defaultValue ...int) IntAccessor {
 		return nv
 	}
 	valueTo := t.toInt(reflect.Int)
-	nv = &NullInt{IntCommon{Error: valueTo.Err()}}
+	nv = &NullInt{IntCommon{Error: valueTo.Err(), Lost: valueTo.PrecisionLost()}}
 	if defaultInt(nv, defaultValue...) {
 		return nv
 	}
@@ -91,7 +103,7 @@ func (t *Type) Int8(defaultValue ...int8) Int8Accessor {
 		return nv
 	}
 	valueTo := t.toInt(reflect.Int8)
-	nv = &NullInt8{Int8Common{Error: valueTo.Err()}}
+	nv = &NullInt8{Int8Common{Error: valueTo.Err(), Lost: valueTo.PrecisionLost()}}
 	if defaultInt8(nv, defaultValue...) {
 		return nv
 	}
@@ -108,7 +120,7 @@ func (t *Type) Int16(defaultValue ...int16) Int16Accessor {
 		return nv
 	}
 	valueTo := t.toInt(reflect.Int16)
-	nv = &NullInt16{Int16Common{Error: valueTo.Err()}}
+	nv = &NullInt16{Int16Common{Error: valueTo.Err(), Lost: valueTo.PrecisionLost()}}
 	if defaultInt16(nv, defaultValue...) {
 		return nv
 	}
@@ -125,7 +137,7 @@ func (t *Type) Int32(defaultValue ...int32) Int32Accessor {
 		return nv
 	}
 	valueTo := t.toInt(reflect.Int32)
-	nv = &NullInt32{Int32Common{Error: valueTo.Err()}}
+	nv = &NullInt32{Int32Common{Error: valueTo.Err(), Lost: valueTo.PrecisionLost()}}
 	if defaultInt32(nv, defaultValue...) {
 		return nv
 	}
@@ -142,7 +154,7 @@ func (t *Type) Int64(defaultValue ...int64) Int64Accessor {
 		return nv
 	}
 	valueTo := t.toInt(reflect.Int64)
-	nv = &NullInt64{Int64Common{Error: valueTo.Err()}}
+	nv = &NullInt64{Int64Common{Error: valueTo.Err(), Lost: valueTo.PrecisionLost()}}
 	if defaultInt64(nv, defaultValue...) {
 		return nv
 	}
@@ -156,7 +168,7 @@ func (t *Type) Int64(defaultValue ...int64) Int64Accessor {
 func Int32(from int64, defaultValue ...int32) Int32Accessor {
 	nv := &NullInt32{}
 	if safe := isSafeInt(from, 32); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Int32, nil)
 		if defaultInt32(nv, defaultValue...) {
 			return nv
 		}
@@ -171,7 +183,7 @@ func Int32(from int64, defaultValue ...int32) Int32Accessor {
 func Int16(from int64, defaultValue ...int16) Int16Accessor {
 	nv := &NullInt16{}
 	if safe := isSafeInt(from, 16); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Int16, nil)
 		if defaultInt16(nv, defaultValue...) {
 			return nv
 		}
@@ -186,7 +198,7 @@ func Int16(from int64, defaultValue ...int16) Int16Accessor {
 func Int8(from int64, defaultValue ...int8) Int8Accessor {
 	nv := &NullInt8{}
 	if safe := isSafeInt(from, 8); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Int8, nil)
 		if defaultInt8(nv, defaultValue...) {
 			return nv
 		}
@@ -201,7 +213,7 @@ func Int8(from int64, defaultValue ...int8) Int8Accessor {
 func IntUint64(from int64, defaultValue ...uint64) Uint64Accessor {
 	nv := &NullUint64{}
 	if safe := isSafeIntToUint(from, 64); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Uint64, nil)
 		if defaultUint64(nv, defaultValue...) {
 			return nv
 		}
@@ -216,7 +228,7 @@ func IntUint64(from int64, defaultValue ...uint64) Uint64Accessor {
 func IntUint32(from int64, defaultValue ...uint32) Uint32Accessor {
 	nv := &NullUint32{}
 	if safe := isSafeIntToUint(from, 32); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Uint32, nil)
 		if defaultUint32(nv, defaultValue...) {
 			return nv
 		}
@@ -231,7 +243,7 @@ func IntUint32(from int64, defaultValue ...uint32) Uint32Accessor {
 func IntUint16(from int64, defaultValue ...uint16) Uint16Accessor {
 	nv := &NullUint16{}
 	if safe := isSafeIntToUint(from, 16); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Uint16, nil)
 		if defaultUint16(nv, defaultValue...) {
 			return nv
 		}
@@ -246,7 +258,7 @@ func IntUint16(from int64, defaultValue ...uint16) Uint16Accessor {
 func IntUint8(from int64, defaultValue ...uint8) Uint8Accessor {
 	nv := &NullUint8{}
 	if safe := isSafeIntToUint(from, 8); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Uint8, nil)
 		if defaultUint8(nv, defaultValue...) {
 			return nv
 		}
@@ -261,7 +273,7 @@ func IntUint8(from int64, defaultValue ...uint8) Uint8Accessor {
 func Float32Int(from float32, defaultValue ...int) IntAccessor {
 	nv := &NullInt{}
 	if safe := isSafeFloatToInt(float64(from), 32, 64); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Int, nil)
 		if defaultInt(nv, defaultValue...) {
 			return nv
 		}
@@ -276,7 +288,7 @@ func Float32Int(from float32, defaultValue ...int) IntAccessor {
 func Float32Int64(from float32, defaultValue ...int64) Int64Accessor {
 	nv := &NullInt64{}
 	if safe := isSafeFloatToInt(float64(from), 32, 64); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Int64, nil)
 		if defaultInt64(nv, defaultValue...) {
 			return nv
 		}
@@ -291,7 +303,7 @@ func Float32Int64(from float32, defaultValue ...int64) Int64Accessor {
 func Float32Int32(from float32, defaultValue ...int32) Int32Accessor {
 	nv := &NullInt32{}
 	if safe := isSafeFloatToInt(float64(from), 32, 32); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Int32, nil)
 		if defaultInt32(nv, defaultValue...) {
 			return nv
 		}
@@ -306,7 +318,7 @@ func Float32Int32(from float32, defaultValue ...int32) Int32Accessor {
 func Float32Int16(from float32, defaultValue ...int16) Int16Accessor {
 	nv := &NullInt16{}
 	if safe := isSafeFloatToInt(float64(from), 32, 16); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Int16, nil)
 		if defaultInt16(nv, defaultValue...) {
 			return nv
 		}
@@ -321,7 +333,7 @@ func Float32Int16(from float32, defaultValue ...int16) Int16Accessor {
 func Float32Int8(from float32, defaultValue ...int8) Int8Accessor {
 	nv := &NullInt8{}
 	if safe := isSafeFloatToInt(float64(from), 32, 8); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Int8, nil)
 		if defaultInt8(nv, defaultValue...) {
 			return nv
 		}
@@ -336,7 +348,7 @@ func Float32Int8(from float32, defaultValue ...int8) Int8Accessor {
 func FloatInt(from float64, defaultValue ...int) IntAccessor {
 	nv := &NullInt{}
 	if safe := isSafeFloatToInt(float64(from), 64, 64); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Int, nil)
 		if defaultInt(nv, defaultValue...) {
 			return nv
 		}
@@ -351,7 +363,7 @@ func FloatInt(from float64, defaultValue ...int) IntAccessor {
 func FloatInt64(from float64, defaultValue ...int64) Int64Accessor {
 	nv := &NullInt64{}
 	if safe := isSafeFloatToInt(float64(from), 64, 64); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Int64, nil)
 		if defaultInt64(nv, defaultValue...) {
 			return nv
 		}
@@ -366,7 +378,7 @@ func FloatInt64(from float64, defaultValue ...int64) Int64Accessor {
 func FloatInt32(from float64, defaultValue ...int32) Int32Accessor {
 	nv := &NullInt32{}
 	if safe := isSafeFloatToInt(float64(from), 64, 32); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Int32, nil)
 		if defaultInt32(nv, defaultValue...) {
 			return nv
 		}
@@ -381,7 +393,7 @@ func FloatInt32(from float64, defaultValue ...int32) Int32Accessor {
 func FloatInt16(from float64, defaultValue ...int16) Int16Accessor {
 	nv := &NullInt16{}
 	if safe := isSafeFloatToInt(float64(from), 32, 16); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Int16, nil)
 		if defaultInt16(nv, defaultValue...) {
 			return nv
 		}
@@ -396,7 +408,7 @@ func FloatInt16(from float64, defaultValue ...int16) Int16Accessor {
 func FloatInt8(from float64, defaultValue ...int8) Int8Accessor {
 	nv := &NullInt8{}
 	if safe := isSafeFloatToInt(float64(from), 32, 8); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Int8, nil)
 		if defaultInt8(nv, defaultValue...) {
 			return nv
 		}
@@ -586,7 +598,9 @@ func ComplexInt8(from complex128, defaultValue ...int8) Int8Accessor {
 func StringInt(from string, defaultValue ...int) IntAccessor {
 	nv := &NullInt{}
 	pv, err := strconv.ParseInt(from, 0, 64)
-	nv.Error = err
+	if err != nil {
+		nv.Error = newConversionError(from, reflect.Int, err)
+	}
 	if defaultInt(nv, defaultValue...) {
 		return nv
 	}
@@ -600,7 +614,9 @@ func StringInt(from string, defaultValue ...int) IntAccessor {
 func StringInt64(from string, defaultValue ...int64) Int64Accessor {
 	nv := &NullInt64{}
 	pv, err := strconv.ParseInt(from, 0, 64)
-	nv.Error = err
+	if err != nil {
+		nv.Error = newConversionError(from, reflect.Int64, err)
+	}
 	if defaultInt64(nv, defaultValue...) {
 		return nv
 	}
@@ -614,7 +630,9 @@ func StringInt64(from string, defaultValue ...int64) Int64Accessor {
 func StringInt32(from string, defaultValue ...int32) Int32Accessor {
 	nv := &NullInt32{}
 	pv, err := strconv.ParseInt(from, 0, 32)
-	nv.Error = err
+	if err != nil {
+		nv.Error = newConversionError(from, reflect.Int32, err)
+	}
 	if defaultInt32(nv, defaultValue...) {
 		return nv
 	}
@@ -628,7 +646,9 @@ func StringInt32(from string, defaultValue ...int32) Int32Accessor {
 func StringInt16(from string, defaultValue ...int16) Int16Accessor {
 	nv := &NullInt16{}
 	pv, err := strconv.ParseInt(from, 0, 16)
-	nv.Error = err
+	if err != nil {
+		nv.Error = newConversionError(from, reflect.Int16, err)
+	}
 	if defaultInt16(nv, defaultValue...) {
 		return nv
 	}
@@ -642,7 +662,9 @@ func StringInt16(from string, defaultValue ...int16) Int16Accessor {
 func StringInt8(from string, defaultValue ...int8) Int8Accessor {
 	nv := &NullInt8{}
 	pv, err := strconv.ParseInt(from, 0, 8)
-	nv.Error = err
+	if err != nil {
+		nv.Error = newConversionError(from, reflect.Int8, err)
+	}
 	if defaultInt8(nv, defaultValue...) {
 		v := defaultValue[0]
 		nv.P = &v
@@ -0,0 +1,73 @@
+package typ
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"reflect"
+)
+
+var jsonNumberType = reflect.TypeOf(json.Number(""))
+
+// ParseJSON create type converter from JSON document.
+// Numbers are decoded as json.Number, so conversion of large integers is exact
+func ParseJSON(data []byte, options ...Option) *Type {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	v, err := decodeJSON(dec)
+	if err == nil {
+		if _, tokenErr := dec.Token(); tokenErr != io.EOF {
+			v, err = nil, ErrUnexpectedValue
+		}
+	}
+	return NewType(v, err, options...)
+}
+
+// DecodeJSON create type converter from the next JSON value read from reader, data may be read beyond the value.
+// Numbers are decoded as json.Number, so conversion of large integers is exact
+func DecodeJSON(r io.Reader, options ...Option) *Type {
+	v, err := decodeJSON(json.NewDecoder(r))
+	return NewType(v, err, options...)
+}
+
+// Decode the next JSON value, numbers are decoded as json.Number
+func decodeJSON(dec *json.Decoder) (interface{}, error) {
+	var v interface{}
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Returns type of exact Go value parsed from json.Number, big number or Decimal, e.g. int64 for "42" and float64 for "4.2".
+// Big number or Decimal without exact Go value is returned as invalid value, so conversion of it fails with origin value
+func (t *Type) fromNumber() (*Type, bool) {
+	if !t.rv.IsValid() {
+		return nil, false
+	}
+	var (
+		v     interface{}
+		exact = true
+	)
+	nt := &Type{opts: t.opts, err: t.err, path: t.path, origin: t.origin}
+	switch t.rv.Type() {
+	case jsonNumberType:
+		var err error
+		if v, err = jsonNumberValue(json.Number(t.rv.String())); err != nil {
+			return nil, false
+		}
+	case bigIntType, bigFloatType, bigRatType:
+		v, exact = bigValue(t.rv)
+		nt.origin = t.rv
+	case decimalType:
+		v, exact = decimalValue(t.rv.Interface().(Decimal))
+		nt.origin = t.rv
+	default:
+		return nil, false
+	}
+	if exact {
+		nt.rv = reflect.ValueOf(v)
+	}
+	nt.kind = nt.rv.Kind()
+	return nt, true
+}
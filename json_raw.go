@@ -0,0 +1,242 @@
+package typ
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// RawJSON is a JSON document navigated without full decoding.
+// Only the value located by index keys is decoded, other values are skipped by byte scanner
+// and aren't validated
+type RawJSON struct {
+	data    []byte
+	options []Option
+}
+
+// OfJSON create lazy navigator over raw JSON document.
+// Numbers are decoded as json.Number, so conversion of large integers is exact
+func OfJSON(data []byte, options ...Option) *RawJSON {
+	return &RawJSON{data, options}
+}
+
+// Get retrieve value from JSON document, argument values used as index keys.
+// String keys are used for objects and int keys for arrays, errors are the same as for Type.Get
+func (j *RawJSON) Get(keys ...interface{}) *Type {
+	return j.get(keys, false)
+}
+
+// Path retrieve value from JSON document by path in dot notation, e.g. "users.0.name"
+func (j *RawJSON) Path(path string) *Type {
+	keys, err := parseDotPath(path)
+	if err != nil {
+		return NewType(nil, err)
+	}
+	return j.get(keys, true)
+}
+
+// Pointer retrieve value from JSON document by JSON Pointer (RFC 6901), e.g. "/users/0/name"
+func (j *RawJSON) Pointer(pointer string) *Type {
+	keys, err := parsePointer(pointer)
+	if err != nil {
+		return NewType(nil, err)
+	}
+	if len(keys) == 0 {
+		v, err := decodeJSON(json.NewDecoder(bytes.NewReader(j.data)))
+		return NewType(v, err, j.options...)
+	}
+	return j.get(keys, true)
+}
+
+// Retrieve value from JSON document by index keys.
+// If coerce is true, string keys of arrays are converted to int
+func (j *RawJSON) get(keys []interface{}, coerce bool) *Type {
+	if len(keys) == 0 {
+		return NewType(nil, ErrInvalidArgument)
+	}
+	var (
+		start = skipJSONSpace(j.data, 0)
+		end   int
+		err   error
+	)
+	for _, key := range keys {
+		if start >= len(j.data) {
+			return NewType(nil, ErrUnexpectedValue)
+		}
+		switch j.data[start] {
+		case '{':
+			name, ok := key.(string)
+			if !ok {
+				return NewType(nil, ErrInvalidArgument)
+			}
+			start, end, err = jsonObjectMember(j.data, start, name)
+		case '[':
+			if segment, ok := key.(string); ok && coerce {
+				if key, err = arrayIndex(segment); err != nil {
+					return NewType(nil, err)
+				}
+			}
+			index, ok := key.(int)
+			if !ok {
+				return NewType(nil, ErrInvalidArgument)
+			}
+			start, end, err = jsonArrayElement(j.data, start, index)
+		default:
+			err = ErrUnexpectedValue
+		}
+		if err != nil {
+			return NewType(nil, err)
+		}
+		if j.data[start] == 'n' {
+			return NewType(nil, ErrOutOfBounds)
+		}
+	}
+	v, err := decodeJSON(json.NewDecoder(bytes.NewReader(j.data[start:end])))
+	typed := NewType(v, err, j.options...)
+	typed.path = keys
+	return typed
+}
+
+// Returns bounds of the last member value of JSON object started at offset i.
+// Returns ErrOutOfBounds if member isn't present
+func jsonObjectMember(data []byte, i int, name string) (int, int, error) {
+	start, end := -1, -1
+	i = skipJSONSpace(data, i+1)
+	if i < len(data) && data[i] == '}' {
+		return start, end, ErrOutOfBounds
+	}
+	for i < len(data) {
+		keyStart, keyEnd, err := skipJSONValue(data, i)
+		if err != nil || data[keyStart] != '"' {
+			return start, end, ErrUnexpectedValue
+		}
+		if i = skipJSONSpace(data, keyEnd); i >= len(data) || data[i] != ':' {
+			return start, end, ErrUnexpectedValue
+		}
+		valueStart, valueEnd, err := skipJSONValue(data, skipJSONSpace(data, i+1))
+		if err != nil {
+			return start, end, err
+		}
+		if jsonKeyEquals(data[keyStart:keyEnd], name) {
+			start, end = valueStart, valueEnd
+		}
+		if i = skipJSONSpace(data, valueEnd); i < len(data) && data[i] == '}' {
+			if start < 0 {
+				return start, end, ErrOutOfBounds
+			}
+			return start, end, nil
+		}
+		if i >= len(data) || data[i] != ',' {
+			return start, end, ErrUnexpectedValue
+		}
+		i = skipJSONSpace(data, i+1)
+	}
+	return start, end, ErrUnexpectedValue
+}
+
+// Returns bounds of element of JSON array started at offset i.
+// Returns ErrOutOfRange if index out of range
+func jsonArrayElement(data []byte, i int, index int) (int, int, error) {
+	if index < 0 {
+		return -1, -1, ErrOutOfRange
+	}
+	i = skipJSONSpace(data, i+1)
+	if i < len(data) && data[i] == ']' {
+		return -1, -1, ErrOutOfRange
+	}
+	for n := 0; i < len(data); n++ {
+		start, end, err := skipJSONValue(data, i)
+		if err != nil {
+			return start, end, err
+		}
+		if n == index {
+			return start, end, nil
+		}
+		if i = skipJSONSpace(data, end); i < len(data) && data[i] == ']' {
+			return -1, -1, ErrOutOfRange
+		}
+		if i >= len(data) || data[i] != ',' {
+			return -1, -1, ErrUnexpectedValue
+		}
+		i = skipJSONSpace(data, i+1)
+	}
+	return -1, -1, ErrUnexpectedValue
+}
+
+// Determine whether a raw JSON string is equal to name
+func jsonKeyEquals(raw []byte, name string) bool {
+	if bytes.IndexByte(raw, '\\') < 0 {
+		return string(raw[1:len(raw)-1]) == name
+	}
+	var key string
+	return json.Unmarshal(raw, &key) == nil && key == name
+}
+
+// Returns bounds of JSON value started at offset i, nested values are skipped by brackets
+func skipJSONValue(data []byte, i int) (int, int, error) {
+	if i >= len(data) {
+		return i, i, ErrUnexpectedValue
+	}
+	start := i
+	switch data[i] {
+	case '"':
+		end, err := skipJSONString(data, i)
+		return start, end, err
+	case '{', '[':
+		depth := 0
+		for i < len(data) {
+			switch data[i] {
+			case '"':
+				end, err := skipJSONString(data, i)
+				if err != nil {
+					return start, end, err
+				}
+				i = end
+				continue
+			case '{', '[':
+				depth++
+			case '}', ']':
+				if depth--; depth == 0 {
+					return start, i + 1, nil
+				}
+			}
+			i++
+		}
+		return start, i, ErrUnexpectedValue
+	}
+	for ; i < len(data); i++ {
+		switch data[i] {
+		case ',', ':', '}', ']', ' ', '\t', '\n', '\r':
+			if i == start {
+				return start, i, ErrUnexpectedValue
+			}
+			return start, i, nil
+		}
+	}
+	return start, i, nil
+}
+
+// Returns offset after JSON string started at offset i
+func skipJSONString(data []byte, i int) (int, error) {
+	for i++; i < len(data); i++ {
+		switch data[i] {
+		case '\\':
+			i++
+		case '"':
+			return i + 1, nil
+		}
+	}
+	return i, ErrUnexpectedValue
+}
+
+// Returns offset of the next non-whitespace byte
+func skipJSONSpace(data []byte, i int) int {
+	for i < len(data) {
+		switch data[i] {
+		case ' ', '\t', '\n', '\r':
+			i++
+		default:
+			return i
+		}
+	}
+	return i
+}
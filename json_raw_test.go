@@ -0,0 +1,114 @@
+package typ
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestOfJSON(t *testing.T) {
+	raw := []byte(` {
+		"id": 1234567890123456789,
+		"a": [0, {"x": "}]"}, null, {"b": {"c": [1, 2.5, "str"]}, "b\"q": 1}],
+		"esc\u0061ped": true,
+		"dup": 1, "dup": 2,
+		"empty": {}, "list": []
+	} `)
+	var decoded interface{}
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	testData := []struct {
+		keys     []interface{}
+		expected interface{}
+		err      error
+	}{
+		{[]interface{}{"id"}, json.Number("1234567890123456789"), nil},
+		{[]interface{}{"a", 1, "x"}, "}]", nil},
+		{[]interface{}{"a", 3, "b", "c"}, []interface{}{json.Number("1"), json.Number("2.5"), "str"}, nil},
+		{[]interface{}{"a", 3, "b", "c", 2}, "str", nil},
+		{[]interface{}{"a", 3, "b\"q"}, json.Number("1"), nil},
+		{[]interface{}{"escaped"}, true, nil},
+		{[]interface{}{"dup"}, json.Number("2"), nil},
+		{[]interface{}{"empty"}, map[string]interface{}{}, nil},
+		{[]interface{}{"a", 2}, nil, ErrOutOfBounds},
+		{[]interface{}{"a", 2, "b"}, nil, ErrOutOfBounds},
+		{[]interface{}{"missing"}, nil, ErrOutOfBounds},
+		{[]interface{}{"empty", "a"}, nil, ErrOutOfBounds},
+		{[]interface{}{"a", 4}, nil, ErrOutOfRange},
+		{[]interface{}{"a", -1}, nil, ErrOutOfRange},
+		{[]interface{}{"list", 0}, nil, ErrOutOfRange},
+		{[]interface{}{"a", "0"}, nil, ErrInvalidArgument},
+		{[]interface{}{1}, nil, ErrInvalidArgument},
+		{[]interface{}{"id", "x"}, nil, ErrUnexpectedValue},
+		{[]interface{}{}, nil, ErrInvalidArgument},
+	}
+	for _, v := range testData {
+		nv := OfJSON(raw).Get(v.keys...).Interface()
+		if nv.Err() != v.err || !reflect.DeepEqual(nv.V(), v.expected) {
+			t.Errorf("OfJSON(raw).Get(%v) failed, expected (expected == actual) %v == %v, error (%v == %v)", v.keys, v.expected, nv.V(), v.err, nv.Err())
+		}
+		if v.err == nil || len(v.keys) == 0 {
+			continue
+		}
+		if ev := Of(decoded).Get(v.keys...).Interface(); ev.Err() != nv.Err() {
+			t.Errorf("OfJSON(raw).Get(%v) failed, expected the same error as Of(decoded).Get %v instead of %v", v.keys, ev.Err(), nv.Err())
+		}
+	}
+	if nv := OfJSON(raw).Get("id").Int64(); nv.V() != 1234567890123456789 || nv.Err() != nil {
+		t.Errorf("OfJSON(raw).Get(\"id\").Int64() failed, expected value by reference %s", errNull{int64(1234567890123456789), true, nil, nv.V(), nv.Valid(), nv.Err()})
+	}
+	var ce *ConversionError
+	if nv := OfJSON(raw).Path("a.3.b.c.1").Int(); !errors.As(nv.Err(), &ce) || ce.Path != "a.3.b.c.1" {
+		t.Errorf("OfJSON(raw).Path(\"a.3.b.c.1\").Int() failed, expected conversion error with path instead of %v", nv.Err())
+	}
+	if nv := OfJSON(raw).Pointer("/a/3/b\"q").Int8(); nv.V() != 1 || nv.Err() != nil {
+		t.Errorf("OfJSON(raw).Pointer(\"/a/3/b\\\"q\").Int8() failed, expected value by reference %s", errNull{int8(1), true, nil, nv.V(), nv.Valid(), nv.Err()})
+	}
+	if nv := OfJSON(raw).Pointer("/a/-").Int(); nv.Err() != ErrOutOfRange {
+		t.Errorf("OfJSON(raw).Pointer(\"/a/-\").Int() failed, expected error %v instead of %v", ErrOutOfRange, nv.Err())
+	}
+	if nv := OfJSON(raw).Pointer("/a/01").Int(); nv.Err() != ErrInvalidArgument {
+		t.Errorf("OfJSON(raw).Pointer(\"/a/01\").Int() failed, expected error %v instead of %v", ErrInvalidArgument, nv.Err())
+	}
+	if nv := OfJSON(raw).Pointer("").Get("a", 0).Int(); nv.V() != 0 || nv.Err() != nil {
+		t.Errorf("OfJSON(raw).Pointer(\"\").Get(\"a\", 0).Int() failed, expected value by reference %s", errNull{0, true, nil, nv.V(), nv.Valid(), nv.Err()})
+	}
+	if nv := OfJSON(raw, Precision(1)).Path("a.3.b.c.1").String(); nv.V() != "2.5" {
+		t.Errorf("OfJSON(raw, options...).Path(\"a.3.b.c.1\").String() failed, expected value by reference %s", errNull{"2.5", true, nil, nv.V(), nv.Valid(), nv.Err()})
+	}
+	for _, v := range []string{``, `{"a" 1}`, `{"a": 1`, `{"a": [1, 2}`, `{"a": "1}`, `{"a": }`, `[1 2]`} {
+		if nv := OfJSON([]byte(v)).Path("a.1").Interface(); nv.Err() == nil {
+			t.Errorf("OfJSON(%s).Path(\"a.1\") failed, expected error", v)
+		}
+	}
+}
+
+// BenchmarkOfJSON-8   	    4238	    276456 ns/op	    1424 B/op	      19 allocs/op
+func BenchmarkOfJSON(b *testing.B) {
+	raw := benchmarkJSON()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		OfJSON(raw).Get("items", 999, "id").Int()
+	}
+}
+
+// BenchmarkParseJSON-8   	     229	   5363250 ns/op	  807872 B/op	   21043 allocs/op
+func BenchmarkParseJSON(b *testing.B) {
+	raw := benchmarkJSON()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		ParseJSON(raw).Get("items", 999, "id").Int()
+	}
+}
+
+// Returns JSON document with 1000 items
+func benchmarkJSON() []byte {
+	items := make([]string, 1000)
+	for i := range items {
+		items[i] = `{"id": ` + Of(i).String().V() + `, "name": "item", "tags": ["a", "b"], "price": 10.5}`
+	}
+	return []byte(`{"items": [` + strings.Join(items, ", ") + `]}`)
+}
@@ -0,0 +1,86 @@
+package typ
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseJSON(t *testing.T) {
+	data := []byte(`{"id": 1234567890123456789, "items": [{"price": 1e3}, {"price": 10.5}], "max": 18446744073709551615}`)
+	typ := ParseJSON(data)
+	if typ.Error() != nil {
+		t.Fatalf("ParseJSON(%s) failed, unexpected error %v", data, typ.Error())
+	}
+	if nv := typ.Get("id").Int64(); nv.V() != 1234567890123456789 || nv.Err() != nil {
+		t.Errorf("ParseJSON(data).Get(\"id\").Int64() failed, expected value by reference %s", errNull{int64(1234567890123456789), true, nil, nv.V(), nv.Valid(), nv.Err()})
+	}
+	if nv := typ.Get("max").Uint64(); nv.V() != MaxUint64 || nv.Err() != nil {
+		t.Errorf("ParseJSON(data).Get(\"max\").Uint64() failed, expected value by reference %s", errNull{uint64(MaxUint64), true, nil, nv.V(), nv.Valid(), nv.Err()})
+	}
+	if nv := typ.Path("items.0.price").Int(); nv.V() != 1000 || nv.Err() != nil {
+		t.Errorf("ParseJSON(data).Path(\"items.0.price\").Int() failed, expected value by reference %s", errNull{1000, true, nil, nv.V(), nv.Valid(), nv.Err()})
+	}
+	if nv := typ.Path("items.1.price").Int(); !errors.Is(nv.Err(), ErrConvert) {
+		t.Errorf("ParseJSON(data).Path(\"items.1.price\").Int() failed, expected error %v instead of %v", ErrConvert, nv.Err())
+	}
+	if nv := typ.Path("items.1.price").Float32(); nv.V() != 10.5 || nv.Err() != nil {
+		t.Errorf("ParseJSON(data).Path(\"items.1.price\").Float32() failed, expected value by reference %s", errNull{float32(10.5), true, nil, nv.V(), nv.Valid(), nv.Err()})
+	}
+	if nv := typ.Get("id").String(); nv.V() != "1234567890123456789" {
+		t.Errorf("ParseJSON(data).Get(\"id\").String() failed, expected value by reference %s", errNull{"1234567890123456789", true, nil, nv.V(), nv.Valid(), nv.Err()})
+	}
+	for _, v := range []string{`{"a":`, `{} {}`, ``} {
+		if typ := ParseJSON([]byte(v)); typ.Error() == nil {
+			t.Errorf("ParseJSON(%s) failed, expected error", v)
+		}
+	}
+	if nv := ParseJSON([]byte(`1`), Base(99)).Int(); nv.Err() != ErrBaseInvalid {
+		t.Errorf("ParseJSON(1, Base(99)).Int() failed, expected error %v instead of %v", ErrBaseInvalid, nv.Err())
+	}
+}
+
+func TestDecodeJSON(t *testing.T) {
+	r := strings.NewReader(`{"id": 9007199254740993, "items": [1, 2]}`)
+	typ := DecodeJSON(r)
+	if nv := typ.Get("id").Int64(); nv.V() != 9007199254740993 || nv.Err() != nil {
+		t.Errorf("DecodeJSON(r).Get(\"id\").Int64() failed, expected value by reference %s", errNull{int64(9007199254740993), true, nil, nv.V(), nv.Valid(), nv.Err()})
+	}
+	if nv := typ.Get("items", 1).Int8(); nv.V() != 2 || nv.Err() != nil {
+		t.Errorf("DecodeJSON(r).Get(\"items\", 1).Int8() failed, expected value by reference %s", errNull{int8(2), true, nil, nv.V(), nv.Valid(), nv.Err()})
+	}
+	if typ := DecodeJSON(r); typ.Error() == nil {
+		t.Error("DecodeJSON(r) failed, expected error on end of input")
+	}
+}
+
+func TestJSONNumber(t *testing.T) {
+	testData := []struct {
+		value    json.Number
+		fn       string
+		expected interface{}
+		err      error
+	}{
+		{"42", "Int8", int8(42), nil},
+		{"4.2e1", "Uint", uint(42), nil},
+		{"-1", "Uint", uint(0), ErrConvert},
+		{"1.5", "Int", 0, ErrConvert},
+		{"1.5", "Float32", float32(1.5), nil},
+		{"9007199254740993", "Float", 0.0, ErrConvert},
+		{"2", "Complex64", complex64(2), nil},
+		{"abc", "Int", 0, ErrConvert},
+	}
+	for _, v := range testData {
+		res := reflect.ValueOf(Of(v.value)).MethodByName(v.fn).Call(nil)[0]
+		value := res.MethodByName("V").Call(nil)[0].Interface()
+		err, _ := res.MethodByName("Err").Call(nil)[0].Interface().(error)
+		if !errors.Is(err, v.err) || (v.err == nil && value != v.expected) {
+			t.Errorf("Of(json.Number(%s)).%s() failed, expected (expected == actual) %v == %v, error %v", v.value, v.fn, v.expected, value, err)
+		}
+	}
+	if nv := Of(json.Number("42")).Equals(42); !nv.V() {
+		t.Error("Of(json.Number(42)).Equals(42) failed, expected true")
+	}
+}
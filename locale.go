@@ -0,0 +1,165 @@
+package typ
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// NumberLocale describes formatting of numbers in a locale
+type NumberLocale struct {
+	// Decimal is a decimal separator, e.g. '.' or ','
+	Decimal rune
+	// Group is a separator of digit groups in integer part, zero disables grouping.
+	// Space separators like U+00A0 or U+202F also accept any other space on parsing
+	Group rune
+	// GroupSize is a count of digits in group, 3 is used if it's zero
+	GroupSize int
+	// Minus is a sign of negative numbers, "-" is used if it's empty
+	Minus string
+}
+
+var (
+	localesMu sync.RWMutex
+	locales   = map[string]NumberLocale{
+		"en":    {Decimal: '.', Group: ','},
+		"de":    {Decimal: ',', Group: '.'},
+		"de-CH": {Decimal: '.', Group: '\''},
+		"es":    {Decimal: ',', Group: '.'},
+		"fr":    {Decimal: ',', Group: '\u202f'},
+		"it":    {Decimal: ',', Group: '.'},
+		"pt-BR": {Decimal: ',', Group: '.'},
+		"ru":    {Decimal: ',', Group: '\u00a0'},
+		"sv":    {Decimal: ',', Group: '\u00a0', Minus: "\u2212"},
+	}
+)
+
+// RegisterLocale register number locale under the given name, registered locale replaces existing one.
+// Returns ErrInvalidArgument if separators are digits, letters or equal to each other
+func RegisterLocale(name string, locale NumberLocale) error {
+	if name == "" || !validLocaleRune(locale.Decimal) || locale.Decimal == locale.Group || locale.GroupSize < 0 ||
+		(locale.Group != 0 && !validLocaleRune(locale.Group)) {
+		return ErrInvalidArgument
+	}
+	localesMu.Lock()
+	defer localesMu.Unlock()
+	locales[name] = locale
+	return nil
+}
+
+// LookupLocale returns number locale registered under the given name
+func LookupLocale(name string) (NumberLocale, bool) {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+	l, ok := locales[name]
+	return l, ok
+}
+
+// Locale set number locale by name for formatting of numbers to strings & parsing of numeric strings,
+// e.g. Locale("de") formats 1234.5 as "1.234,5". Returns ErrInvalidArgument if locale isn't registered
+func Locale(name string) Option {
+	return func(t *opts) error {
+		l, ok := LookupLocale(name)
+		if !ok {
+			return ErrInvalidArgument
+		}
+		t.locale = &l
+		return nil
+	}
+}
+
+// FloatStringLocale set number locale by name for float conversion to string.
+func FloatStringLocale(name string) FloatStringOption {
+	return func(t *floatStrOpts) error {
+		l, ok := LookupLocale(name)
+		if !ok {
+			return ErrInvalidArgument
+		}
+		t.locale = &l
+		return nil
+	}
+}
+
+// Format numeric string of strconv to the locale, e.g. "-1234.5" to "-1.234,5"
+func (l NumberLocale) format(s string) string {
+	sign := ""
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		sign, s = s[:1], s[1:]
+		if sign == "-" {
+			sign = l.minus()
+		}
+	}
+	intPart, rest := s, ""
+	if i := strings.IndexAny(s, ".eE"); i >= 0 {
+		intPart, rest = s[:i], s[i:]
+	}
+	if !isDigits(intPart) {
+		return sign + s
+	}
+	if strings.HasPrefix(rest, ".") {
+		rest = string(l.Decimal) + rest[1:]
+	}
+	if l.Group != 0 && len(intPart) > l.groupSize() {
+		var b strings.Builder
+		for i, r := range intPart {
+			if i > 0 && (len(intPart)-i)%l.groupSize() == 0 {
+				b.WriteRune(l.Group)
+			}
+			b.WriteRune(r)
+		}
+		intPart = b.String()
+	}
+	return sign + intPart + rest
+}
+
+// Returns numeric string of the locale in strconv syntax, e.g. "-1.234,5" to "-1234.5".
+// Returns false if string doesn't follow the locale
+func (l NumberLocale) parse(s string, sep rune) (string, bool) {
+	if m := l.minus(); m != "-" && strings.HasPrefix(s, m) {
+		s = "-" + s[len(m):]
+	}
+	if sep == 0 {
+		sep = l.Group
+		if unicode.Is(unicode.Zs, sep) {
+			s = strings.Map(func(r rune) rune {
+				if unicode.Is(unicode.Zs, r) {
+					return sep
+				}
+				return r
+			}, s)
+		}
+	}
+	return removeSeparator(s, sep, l.groupSize(), l.Decimal)
+}
+
+// Returns sign of negative numbers
+func (l NumberLocale) minus() string {
+	if l.Minus == "" {
+		return "-"
+	}
+	return l.Minus
+}
+
+// Returns count of digits in group
+func (l NumberLocale) groupSize() int {
+	if l.GroupSize == 0 {
+		return 3
+	}
+	return l.GroupSize
+}
+
+// Determine whether a rune can be used as separator of numbers
+func validLocaleRune(r rune) bool {
+	return r != 0 && r != '+' && r != '-' && !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+// Determine whether a numeric value formatted with base & fmtByte can be localized
+func localizable(t *Type, base int, fmtByte byte) bool {
+	switch {
+	case t.IsInt(true), t.IsUint(true):
+		return base == 10
+	case t.IsFloat(true):
+		return fmtByte != 'b'
+	}
+	return false
+}
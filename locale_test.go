@@ -0,0 +1,118 @@
+package typ
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLocaleString(t *testing.T) {
+	testData := []struct {
+		value    interface{}
+		options  []Option
+		expected string
+	}{
+		{1234567.891, []Option{Precision(2), Locale("en")}, "1,234,567.89"},
+		{1234567.891, []Option{Precision(2), Locale("de")}, "1.234.567,89"},
+		{-1234.5, []Option{Locale("fr")}, "-1\u202f234,5"},
+		{-1234.5, []Option{Locale("sv")}, "\u22121\u00a0234,5"},
+		{1234.5, []Option{Locale("de-CH")}, "1'234.5"},
+		{123.5, []Option{Locale("de")}, "123,5"},
+		{-1234.5, []Option{Locale("de")}, "-1.234,5"},
+		{float32(0.5), []Option{FmtByte('g'), Locale("de")}, "0,5"},
+		{1234567, []Option{Locale("ru")}, "1\u00a0234\u00a0567"},
+		{-1000, []Option{Locale("en")}, "-1,000"},
+		{uint64(MaxUint64), []Option{Locale("de")}, "18.446.744.073.709.551.615"},
+		{255, []Option{Base(16), Locale("de")}, "ff"},
+		{1.5, []Option{FmtByte('b'), Locale("de")}, "6755399441055744p-52"},
+		{complex(1.5, 2), []Option{Locale("de")}, "(1.5+2i)"},
+		{"1234.5", []Option{Locale("de")}, "1234.5"},
+	}
+	for _, v := range testData {
+		nv := Of(v.value, v.options...).String()
+		if nv.V() != v.expected || nv.Err() != nil {
+			t.Errorf("Of(%v).String() failed, expected %q instead of %q, error %v", v.value, v.expected, nv.V(), nv.Err())
+		}
+	}
+	if nv := Of(1234.5, Locale("de")).String(); nv.V() != "1.234,5" || nv.Err() != nil {
+		t.Errorf("Of(1234.5, Locale(de)).String() failed, expected 1.234,5 with default options instead of %q, error %v", nv.V(), nv.Err())
+	}
+	if nv := FloatString(-9876.5, FloatStringFmtByte('f'), FloatStringLocale("de")); nv.V() != "-9.876,5" || nv.Err() != nil {
+		t.Errorf("FloatString() failed, expected -9.876,5 instead of %q, error %v", nv.V(), nv.Err())
+	}
+	if nv := FloatString(1, FloatStringLocale("unknown")); !errors.Is(nv.Err(), ErrInvalidArgument) {
+		t.Errorf("FloatString() failed, expected error %v instead of %v", ErrInvalidArgument, nv.Err())
+	}
+	if nv := Of(1, Locale("unknown")).String(); !errors.Is(nv.Err(), ErrInvalidArgument) {
+		t.Errorf("Of(1).String() failed, expected error %v instead of %v", ErrInvalidArgument, nv.Err())
+	}
+}
+
+func TestLocaleParse(t *testing.T) {
+	testData := []struct {
+		value    string
+		options  []Option
+		expected float64
+		err      error
+	}{
+		{"1.234,56", []Option{Locale("de")}, 1234.56, nil},
+		{"-1.234.567", []Option{Locale("de")}, -1234567, nil},
+		{"1234,5", []Option{Locale("de")}, 1234.5, nil},
+		{"1,5e3", []Option{Locale("de")}, 1500, nil},
+		{"1.5", []Option{Locale("de")}, 0, ErrConvert},
+		{"1.23,4", []Option{Locale("de")}, 0, ErrConvert},
+		{"1,234.56", []Option{Locale("en")}, 1234.56, nil},
+		{"1,23.5", []Option{Locale("en")}, 0, ErrConvert},
+		{"1 234,5", []Option{Locale("fr")}, 1234.5, nil},
+		{"1234.5", []Option{Locale("fr")}, 0, ErrConvert},
+		{"−1 234,5", []Option{Locale("sv")}, -1234.5, nil},
+		{"-1 234,5", []Option{Locale("sv")}, -1234.5, nil},
+		{" 1'234.5 ", []Option{TrimSpace(), Locale("de-CH")}, 1234.5, nil},
+		{"1_234,5", []Option{Locale("de"), DigitSeparator('_')}, 1234.5, nil},
+	}
+	for _, v := range testData {
+		nv := Of(v.value, v.options...).Float()
+		if nv.V() != v.expected || !errors.Is(nv.Err(), v.err) {
+			t.Errorf("Of(%q).Float() failed, expected (expected == actual) %v == %v, error %v == %v",
+				v.value, v.expected, nv.V(), v.err, nv.Err(),
+			)
+		}
+	}
+	if nv := Of("1.234.567", Locale("de")).Int(); nv.V() != 1234567 || nv.Err() != nil {
+		t.Errorf("Of(1.234.567).Int() failed, expected 1234567 instead of %v, error %v", nv.V(), nv.Err())
+	}
+	if nv := Of("1.234,0", Locale("de"), IntegralFloats()).Uint16(); nv.V() != 1234 || nv.Err() != nil {
+		t.Errorf("Of(1.234,0).Uint16() failed, expected 1234 instead of %v, error %v", nv.V(), nv.Err())
+	}
+	if nv := StringNumber[float64]("12.345,6", Locale("it")); nv.V() != 12345.6 || nv.Err() != nil {
+		t.Errorf("StringNumber[float64]() failed, expected 12345.6 instead of %v, error %v", nv.V(), nv.Err())
+	}
+}
+
+func TestRegisterLocale(t *testing.T) {
+	if err := RegisterLocale("test-IN", NumberLocale{Decimal: '.', Group: ',', GroupSize: 4, Minus: "(-)"}); err != nil {
+		t.Fatalf("RegisterLocale() failed, error %v", err)
+	}
+	if nv := Of(-12345678, Locale("test-IN")).String(); nv.V() != "(-)1234,5678" {
+		t.Errorf("Of(-12345678).String() failed, expected (-)1234,5678 instead of %q", nv.V())
+	}
+	if nv := Of("(-)1234,5678", Locale("test-IN")).Int(); nv.V() != -12345678 || nv.Err() != nil {
+		t.Errorf("Of((-)1234,5678).Int() failed, expected -12345678 instead of %v, error %v", nv.V(), nv.Err())
+	}
+	for _, l := range []NumberLocale{
+		{},
+		{Decimal: ',', Group: ','},
+		{Decimal: 'd'},
+		{Decimal: '.', Group: '1'},
+		{Decimal: '.', GroupSize: -1},
+	} {
+		if err := RegisterLocale("invalid", l); !errors.Is(err, ErrInvalidArgument) {
+			t.Errorf("RegisterLocale(%v) failed, expected error %v instead of %v", l, ErrInvalidArgument, err)
+		}
+	}
+	if err := RegisterLocale("", NumberLocale{Decimal: '.'}); !errors.Is(err, ErrInvalidArgument) {
+		t.Errorf("RegisterLocale() failed, expected error %v instead of %v", ErrInvalidArgument, err)
+	}
+	if _, ok := LookupLocale("invalid"); ok {
+		t.Errorf("LookupLocale(invalid) failed, locale must not be registered")
+	}
+}
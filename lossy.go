@@ -0,0 +1,276 @@
+package typ
+
+import (
+	"math"
+	"reflect"
+)
+
+// RoundingMode is a policy of rounding float values converted to integer types
+type RoundingMode int
+
+const (
+	// RoundNone rejects conversion of float values with fractional part
+	RoundNone RoundingMode = iota
+	// RoundNearest rounds to the nearest integer, half away from zero
+	RoundNearest
+	// RoundFloor rounds toward negative infinity
+	RoundFloor
+	// RoundCeil rounds toward positive infinity
+	RoundCeil
+	// RoundTruncate rounds toward zero
+	RoundTruncate
+)
+
+// OverflowMode is a policy of values out of range of integer types
+type OverflowMode int
+
+const (
+	// OverflowError rejects conversion of values out of range
+	OverflowError OverflowMode = iota
+	// OverflowClamp saturates value to the minimum or maximum of type
+	OverflowClamp
+	// OverflowWrap wraps value around by modulo of type capacity
+	OverflowWrap
+)
+
+// Real is a constraint that permits any integer or float type
+type Real interface {
+	Integer | ~float32 | ~float64
+}
+
+// Integer is a constraint that permits any integer type
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// Rounding set rounding mode for conversion of float and complex values to integer types.
+// The conversion is rejected by default
+func Rounding(mode RoundingMode) Option {
+	return func(t *opts) error {
+		if mode < RoundNone || mode > RoundTruncate {
+			return ErrInvalidArgument
+		}
+		t.rounding = mode
+		return nil
+	}
+}
+
+// Overflow set overflow mode for conversion of numeric values to integer types.
+// The conversion is rejected by default
+func Overflow(mode OverflowMode) Option {
+	return func(t *opts) error {
+		if mode < OverflowError || mode > OverflowWrap {
+			return ErrInvalidArgument
+		}
+		t.overflow = mode
+		return nil
+	}
+}
+
+// Lossy convert integer or float value to integer type T by Rounding and Overflow options without reflection.
+// Returns value if type can be converted by given policies, otherwise error in result values.
+// PrecisionLost of result determines whether a value was changed by policies
+func Lossy[T Integer, F Real](from F, options ...Option) *Null[T] {
+	nv := &Null[T]{}
+	var o opts
+	for _, v := range options {
+		if nv.Error = v(&o); nv.Error != nil {
+			return nv
+		}
+	}
+	var (
+		bits     uint64
+		lost, ok bool
+		bitSize  = integerBitSize[T]()
+		signed   = isSignedType[T]()
+	)
+	switch {
+	case isFloatType[F]():
+		bits, lost, ok = lossyFloat(float64(from), bitSize, signed, o)
+	case isSignedType[F]():
+		bits, lost, ok = lossyInt(int64(from), bitSize, signed, o)
+	default:
+		bits, lost, ok = lossyUint(uint64(from), bitSize, signed, o)
+	}
+	if !ok {
+		nv.Error = newConversionError(from, integerKind(bitSize, signed), nil)
+		return nv
+	}
+	v := T(bits)
+	nv.P, nv.Lost = &v, lost
+	return nv
+}
+
+// LossyComplex convert complex value to integer type T by Rounding and Overflow options without reflection.
+// The imaginary part must be zero. Returns value if type can be converted by given policies, otherwise error in result values.
+// PrecisionLost of result determines whether a value was changed by policies
+func LossyComplex[T Integer, F ~complex64 | ~complex128](from F, options ...Option) *Null[T] {
+	if imag(complex128(from)) != 0 {
+		return &Null[T]{Error: newConversionError(from, integerKind(integerBitSize[T](), isSignedType[T]()), nil)}
+	}
+	return Lossy[T](real(complex128(from)), options...)
+}
+
+// Convert value by rounding and overflow options to integer kind.
+// Returns bits of int64 or uint64 value, whether a value was changed and whether a conversion succeeded
+func (t *Type) toLossy(typeTo reflect.Kind) (uint64, bool, bool) {
+	if nt, ok := t.fromNumber(); ok {
+		return nt.toLossy(typeTo)
+	}
+	bitSize, signed := bitSizeMap[typeTo], isInt(typeTo)
+	switch {
+	case t.IsInt(true):
+		return lossyInt(t.rv.Int(), bitSize, signed, t.opts)
+	case t.IsUint(true):
+		return lossyUint(t.rv.Uint(), bitSize, signed, t.opts)
+	case t.IsFloat(true):
+		return lossyFloat(t.rv.Float(), bitSize, signed, t.opts)
+	case t.IsComplex(true):
+		if imag(t.rv.Complex()) != 0 {
+			return 0, false, false
+		}
+		return lossyFloat(real(t.rv.Complex()), bitSize, signed, t.opts)
+	}
+	return 0, false, false
+}
+
+// Determine whether rounding or overflow policy is set
+func (o opts) lossy() bool {
+	return o.rounding != RoundNone || o.overflow != OverflowError
+}
+
+// Round float value by rounding mode
+func roundFloat(from float64, mode RoundingMode) float64 {
+	switch mode {
+	case RoundNearest:
+		return math.Round(from)
+	case RoundFloor:
+		return math.Floor(from)
+	case RoundCeil:
+		return math.Ceil(from)
+	case RoundTruncate:
+		return math.Trunc(from)
+	}
+	return from
+}
+
+// Convert float value to integer bits by rounding and overflow options
+func lossyFloat(from float64, bitSize int, signed bool, o opts) (uint64, bool, bool) {
+	if math.IsNaN(from) {
+		return 0, false, false
+	}
+	var lost bool
+	if math.Trunc(from) != from {
+		if o.rounding == RoundNone {
+			return 0, false, false
+		}
+		from, lost = roundFloat(from, o.rounding), true
+	}
+	min, max := 0.0, math.Ldexp(1, bitSize)
+	if signed {
+		min, max = -math.Ldexp(1, bitSize-1), math.Ldexp(1, bitSize-1)
+	}
+	switch {
+	case from >= min && from < max && signed:
+		return uint64(int64(from)), lost, true
+	case from >= min && from < max:
+		return uint64(from), lost, true
+	case o.overflow == OverflowClamp:
+		return clampBits(from < 0, bitSize, signed), true, true
+	case o.overflow == OverflowWrap && !math.IsInf(from, 0):
+		m := math.Mod(from, math.Ldexp(1, bitSize))
+		var bits uint64
+		switch {
+		case m >= 0:
+			bits = uint64(m)
+		case m >= math.MinInt64:
+			bits = uint64(int64(m))
+		default:
+			bits = uint64(m + math.Ldexp(1, 64))
+		}
+		return wrapBits(bits, bitSize, signed), true, true
+	}
+	return 0, false, false
+}
+
+// Convert int value to integer bits by overflow options
+func lossyInt(from int64, bitSize int, signed bool, o opts) (uint64, bool, bool) {
+	if (signed && isSafeInt(from, bitSize)) || (!signed && isSafeIntToUint(from, bitSize)) {
+		return uint64(from), false, true
+	}
+	switch o.overflow {
+	case OverflowClamp:
+		return clampBits(from < 0, bitSize, signed), true, true
+	case OverflowWrap:
+		return wrapBits(uint64(from), bitSize, signed), true, true
+	}
+	return 0, false, false
+}
+
+// Convert uint value to integer bits by overflow options
+func lossyUint(from uint64, bitSize int, signed bool, o opts) (uint64, bool, bool) {
+	if (signed && isSafeUintToInt(from, bitSize)) || (!signed && isSafeUint(from, bitSize)) {
+		return from, false, true
+	}
+	switch o.overflow {
+	case OverflowClamp:
+		return clampBits(false, bitSize, signed), true, true
+	case OverflowWrap:
+		return wrapBits(from, bitSize, signed), true, true
+	}
+	return 0, false, false
+}
+
+// Returns bits of minimum or maximum value of integer type
+func clampBits(negative bool, bitSize int, signed bool) uint64 {
+	switch {
+	case signed && negative:
+		return uint64(int64(-1) << uint(bitSize-1))
+	case signed:
+		return uint64(1)<<uint(bitSize-1) - 1
+	case negative:
+		return 0
+	}
+	return uint64(1)<<uint(bitSize) - 1
+}
+
+// Returns bits truncated to bit size of integer type, signed values are sign extended
+func wrapBits(bits uint64, bitSize int, signed bool) uint64 {
+	mask := uint64(1)<<uint(bitSize) - 1
+	bits &= mask
+	if signed && bits&(uint64(1)<<uint(bitSize-1)) != 0 {
+		bits |= ^mask
+	}
+	return bits
+}
+
+// Returns kind of integer type by bit size
+func integerKind(bitSize int, signed bool) reflect.Kind {
+	kinds := map[int]reflect.Kind{8: reflect.Int8, 16: reflect.Int16, 32: reflect.Int32, 64: reflect.Int64}
+	if !signed {
+		kinds = map[int]reflect.Kind{8: reflect.Uint8, 16: reflect.Uint16, 32: reflect.Uint32, 64: reflect.Uint64}
+	}
+	return kinds[bitSize]
+}
+
+// Returns bit size of integer type T
+func integerBitSize[T Integer]() int {
+	bitSize := 0
+	for v := T(1); v != 0; v <<= 1 {
+		bitSize++
+	}
+	return bitSize
+}
+
+// Determine whether a type T is signed
+func isSignedType[T Real]() bool {
+	var zero T
+	return zero-1 < zero
+}
+
+// Determine whether a type T is float
+func isFloatType[T Real]() bool {
+	one := T(1)
+	return one/2 != 0
+}
@@ -0,0 +1,102 @@
+package typ
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestLossy(t *testing.T) {
+	testData := []struct {
+		value    interface{}
+		options  []Option
+		expected int64
+		lost     bool
+		err      error
+	}{
+		{3.5, []Option{Rounding(RoundNearest)}, 4, true, nil},
+		{-3.5, []Option{Rounding(RoundNearest)}, -4, true, nil},
+		{3.7, []Option{Rounding(RoundFloor)}, 3, true, nil},
+		{-3.2, []Option{Rounding(RoundFloor)}, -4, true, nil},
+		{3.2, []Option{Rounding(RoundCeil)}, 4, true, nil},
+		{-3.7, []Option{Rounding(RoundTruncate)}, -3, true, nil},
+		{float32(3), []Option{Rounding(RoundNearest)}, 3, false, nil},
+		{complex(2.5, 0), []Option{Rounding(RoundTruncate)}, 2, true, nil},
+		{complex(2.5, 1), []Option{Rounding(RoundTruncate)}, 2, false, ErrConvert},
+		{300, []Option{Overflow(OverflowClamp)}, 127, true, nil},
+		{-300, []Option{Overflow(OverflowClamp)}, -128, true, nil},
+		{uint64(MaxUint64), []Option{Overflow(OverflowClamp)}, 127, true, nil},
+		{300, []Option{Overflow(OverflowWrap)}, 44, true, nil},
+		{-129, []Option{Overflow(OverflowWrap)}, 127, true, nil},
+		{uint64(255), []Option{Overflow(OverflowWrap)}, -1, true, nil},
+		{1e300, []Option{Overflow(OverflowClamp)}, 127, true, nil},
+		{math.Inf(-1), []Option{Overflow(OverflowClamp)}, -128, true, nil},
+		{300.0, []Option{Overflow(OverflowWrap)}, 44, true, nil},
+		{-129.0, []Option{Overflow(OverflowWrap)}, 127, true, nil},
+		{299.6, []Option{Rounding(RoundNearest), Overflow(OverflowWrap)}, 44, true, nil},
+		{299.6, []Option{Rounding(RoundNearest)}, 0, false, ErrConvert},
+		{3.5, []Option{Overflow(OverflowClamp)}, 0, false, ErrConvert},
+		{math.NaN(), []Option{Rounding(RoundNearest), Overflow(OverflowClamp)}, 0, false, ErrConvert},
+		{math.Inf(1), []Option{Overflow(OverflowWrap)}, 0, false, ErrConvert},
+		{"3.5", []Option{Rounding(RoundNearest)}, 0, false, ErrConvert},
+		{3.5, []Option{Rounding(RoundingMode(99))}, 0, false, ErrInvalidArgument},
+	}
+	for _, v := range testData {
+		nv := Of(v.value, v.options...).Int8()
+		if (v.err == nil && int64(nv.V()) != v.expected) || nv.PrecisionLost() != v.lost || !errors.Is(nv.Err(), v.err) {
+			t.Errorf("Of(%v).Int8() failed, expected (expected == actual) %v == %v, lost %v == %v, error %v == %v",
+				v.value, v.expected, nv.V(), v.lost, nv.PrecisionLost(), v.err, nv.Err(),
+			)
+		}
+		gv := To[int8](v.value, v.options...)
+		if gv.V() != nv.V() || gv.PrecisionLost() != nv.PrecisionLost() || !errors.Is(gv.Err(), v.err) {
+			t.Errorf("To[int8](%v) failed, expected (expected == actual) %v == %v, lost %v == %v, error %v == %v",
+				v.value, nv.V(), gv.V(), nv.PrecisionLost(), gv.PrecisionLost(), v.err, gv.Err(),
+			)
+		}
+	}
+	if nv := Of(-1.5, Rounding(RoundNearest), Overflow(OverflowClamp)).Uint64(); nv.V() != 0 || !nv.PrecisionLost() || nv.Err() != nil {
+		t.Errorf("Of(-1.5).Uint64() failed, expected 0 instead of %v, error %v", nv.V(), nv.Err())
+	}
+	if nv := Of(math.MaxFloat64, Overflow(OverflowClamp)).Uint64(); nv.V() != MaxUint64 || !nv.PrecisionLost() {
+		t.Errorf("Of(math.MaxFloat64).Uint64() failed, expected %v instead of %v, error %v", uint64(MaxUint64), nv.V(), nv.Err())
+	}
+	if nv := Of(-1, Overflow(OverflowWrap)).Uint32(); nv.V() != MaxUint32 || !nv.PrecisionLost() {
+		t.Errorf("Of(-1).Uint32() failed, expected %v instead of %v, error %v", uint32(MaxUint32), nv.V(), nv.Err())
+	}
+	if nv := Of(float64(-1<<63), Overflow(OverflowWrap)).Int64(); nv.V() != MinInt64 || nv.PrecisionLost() {
+		t.Errorf("Of(-1 << 63).Int64() failed, expected %v instead of %v, error %v", int64(MinInt64), nv.V(), nv.Err())
+	}
+	if nv := Of(2.5, Rounding(RoundNearest)).Int().Clone(); nv.V() != 3 || !nv.PrecisionLost() {
+		t.Errorf("Of(2.5).Int().Clone() failed, expected lost precision to be preserved")
+	}
+}
+
+func TestLossyNative(t *testing.T) {
+	if nv := Lossy[int](3.1415926535, Rounding(RoundNearest)); nv.V() != 3 || !nv.PrecisionLost() || nv.Err() != nil {
+		t.Errorf("Lossy[int](3.1415926535) failed, expected value by reference %s", errNull{3, true, nil, nv.V(), nv.Valid(), nv.Err()})
+	}
+	if nv := Lossy[int](3.1415926535); nv.Present() || !errors.Is(nv.Err(), ErrConvert) {
+		t.Errorf("Lossy[int](3.1415926535) failed, expected value by reference %s", errNull{nil, false, ErrConvert, nv.V(), nv.Valid(), nv.Err()})
+	}
+	if nv := Lossy[uint8](int64(300), Overflow(OverflowClamp)); nv.V() != 255 || !nv.PrecisionLost() {
+		t.Errorf("Lossy[uint8](300) failed, expected value by reference %s", errNull{uint8(255), true, nil, nv.V(), nv.Valid(), nv.Err()})
+	}
+	if nv := Lossy[uint8](int64(-1), Overflow(OverflowWrap)); nv.V() != 255 || !nv.PrecisionLost() {
+		t.Errorf("Lossy[uint8](-1) failed, expected value by reference %s", errNull{uint8(255), true, nil, nv.V(), nv.Valid(), nv.Err()})
+	}
+	if nv := Lossy[int16](uint32(40000), Overflow(OverflowClamp)); nv.V() != MaxInt16 || !nv.PrecisionLost() {
+		t.Errorf("Lossy[int16](40000) failed, expected value by reference %s", errNull{int16(MaxInt16), true, nil, nv.V(), nv.Valid(), nv.Err()})
+	}
+	if nv := Lossy[int16](int16(42)); nv.V() != 42 || nv.PrecisionLost() || nv.Err() != nil {
+		t.Errorf("Lossy[int16](42) failed, expected value by reference %s", errNull{int16(42), true, nil, nv.V(), nv.Valid(), nv.Err()})
+	}
+	if nv := LossyComplex[int64](complex64(complex(-2.5, 0)), Rounding(RoundFloor)); nv.V() != -3 || !nv.PrecisionLost() {
+		t.Errorf("LossyComplex[int64](-2.5+0i) failed, expected value by reference %s", errNull{int64(-3), true, nil, nv.V(), nv.Valid(), nv.Err()})
+	}
+	var ce *ConversionError
+	if nv := LossyComplex[int64](complex(1, 1), Rounding(RoundFloor)); !errors.As(nv.Err(), &ce) || ce.Reason != ReasonImaginary || ce.To != reflect.Int64 {
+		t.Errorf("LossyComplex[int64](1+1i) failed, expected imaginary conversion error instead of %v", nv.Err())
+	}
+}
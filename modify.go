@@ -0,0 +1,220 @@
+package typ
+
+import (
+	"reflect"
+)
+
+// SetPath saves value into composite type located by pointer doc, argument values used as index keys.
+// Missing intermediate maps and slices are created: int keys create []interface{}, other keys create
+// map[string]interface{} for string keys or map[interface{}]interface{} otherwise, slices grow to fit the index.
+// Value is converted to the element type of existing container by the same safe rules as Decode
+func SetPath(doc interface{}, value interface{}, keys ...interface{}) error {
+	rv := reflect.ValueOf(doc)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return ErrInvalidArgument
+	}
+	return modifyPath(rv.Elem(), keys, true, func(dst reflect.Value) error {
+		return assignValue(dst, value)
+	})
+}
+
+// DeletePath removes value from composite type located by pointer doc, argument values used as index keys.
+// Map keys are deleted, slice elements are removed with shifting of next elements and
+// struct fields are set to zero value
+func DeletePath(doc interface{}, keys ...interface{}) error {
+	rv := reflect.ValueOf(doc)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || len(keys) == 0 {
+		return ErrInvalidArgument
+	}
+	last := keys[len(keys)-1]
+	return modifyPath(rv.Elem(), keys[:len(keys)-1], false, func(dst reflect.Value) error {
+		return deleteIndex(dst, last)
+	})
+}
+
+// Walk settable value by keys and call fn for located value.
+// If create is true, missing containers are created. Changes are saved only if fn succeeded
+func modifyPath(dst reflect.Value, keys []interface{}, create bool, fn func(dst reflect.Value) error) error {
+	if len(keys) == 0 {
+		return fn(dst)
+	}
+	key := keys[0]
+	switch dst.Kind() {
+	case reflect.Interface:
+		if dst.IsNil() {
+			if !create {
+				return ErrOutOfBounds
+			}
+			v := reflect.New(containerType(key)).Elem()
+			if err := modifyPath(v, keys, create, fn); err != nil {
+				return err
+			}
+			dst.Set(v)
+			return nil
+		}
+		v := reflect.New(dst.Elem().Type()).Elem()
+		v.Set(dst.Elem())
+		if err := modifyPath(v, keys, create, fn); err != nil {
+			return err
+		}
+		dst.Set(v)
+	case reflect.Ptr:
+		if dst.IsNil() {
+			if !create {
+				return ErrOutOfBounds
+			}
+			v := reflect.New(dst.Type().Elem())
+			if err := modifyPath(v.Elem(), keys, create, fn); err != nil {
+				return err
+			}
+			dst.Set(v)
+			return nil
+		}
+		return modifyPath(dst.Elem(), keys, create, fn)
+	case reflect.Map:
+		rk := reflect.ValueOf(key)
+		if !rk.IsValid() || !rk.Type().AssignableTo(dst.Type().Key()) {
+			return ErrInvalidArgument
+		}
+		v := reflect.New(dst.Type().Elem()).Elem()
+		if ev := dst.MapIndex(rk); ev.IsValid() {
+			v.Set(ev)
+		} else if !create {
+			return ErrOutOfBounds
+		}
+		if err := modifyPath(v, keys[1:], create, fn); err != nil {
+			return err
+		}
+		if dst.IsNil() {
+			dst.Set(reflect.MakeMap(dst.Type()))
+		}
+		dst.SetMapIndex(rk, v)
+	case reflect.Slice:
+		index, ok := key.(int)
+		if !ok {
+			return ErrInvalidArgument
+		}
+		if index < 0 || (!create && index > dst.Len()-1) {
+			return ErrOutOfRange
+		}
+		v := dst
+		if index > dst.Len()-1 {
+			v = reflect.MakeSlice(dst.Type(), index+1, index+1)
+			reflect.Copy(v, dst)
+		}
+		if err := modifyPath(v.Index(index), keys[1:], create, fn); err != nil {
+			return err
+		}
+		dst.Set(v)
+	case reflect.Array:
+		index, ok := key.(int)
+		if !ok {
+			return ErrInvalidArgument
+		}
+		if index < 0 || index > dst.Len()-1 {
+			return ErrOutOfRange
+		}
+		return modifyPath(dst.Index(index), keys[1:], create, fn)
+	case reflect.Struct:
+		name, ok := key.(string)
+		if !ok {
+			return ErrUnexpectedValue
+		}
+		v, err := structField(dst, name)
+		if err != nil {
+			return err
+		}
+		return modifyPath(v, keys[1:], create, fn)
+	default:
+		return ErrUnexpectedValue
+	}
+	return nil
+}
+
+// Returns type of container created for missing key
+func containerType(key interface{}) reflect.Type {
+	switch key.(type) {
+	case int:
+		return reflect.TypeOf([]interface{}{})
+	case string:
+		return reflect.TypeOf(map[string]interface{}{})
+	}
+	return reflect.TypeOf(map[interface{}]interface{}{})
+}
+
+// Assign value into settable dst, value is converted to the type of dst if it isn't assignable
+func assignValue(dst reflect.Value, value interface{}) error {
+	rv := reflect.ValueOf(value)
+	if !rv.IsValid() {
+		dst.Set(reflect.Zero(dst.Type()))
+		return nil
+	}
+	if rv.Type().AssignableTo(dst.Type()) {
+		dst.Set(rv)
+		return nil
+	}
+	v := reflect.New(dst.Type()).Elem()
+	d := &decoder{}
+	d.decode("", rv, v)
+	switch {
+	case len(d.errors) == 1 && d.errors[0].Path == "":
+		return d.errors[0].Err
+	case len(d.errors) > 0:
+		return &DecodeError{d.errors}
+	}
+	dst.Set(v)
+	return nil
+}
+
+// Remove value from settable composite value by index key
+func deleteIndex(dst reflect.Value, key interface{}) error {
+	switch dst.Kind() {
+	case reflect.Interface, reflect.Ptr:
+		if dst.IsNil() {
+			return ErrOutOfBounds
+		}
+		if dst.Kind() == reflect.Ptr {
+			return deleteIndex(dst.Elem(), key)
+		}
+		v := reflect.New(dst.Elem().Type()).Elem()
+		v.Set(dst.Elem())
+		if err := deleteIndex(v, key); err != nil {
+			return err
+		}
+		dst.Set(v)
+	case reflect.Map:
+		rk := reflect.ValueOf(key)
+		if !rk.IsValid() || !rk.Type().AssignableTo(dst.Type().Key()) {
+			return ErrInvalidArgument
+		}
+		if !dst.MapIndex(rk).IsValid() {
+			return ErrOutOfBounds
+		}
+		dst.SetMapIndex(rk, reflect.Value{})
+	case reflect.Slice:
+		index, ok := key.(int)
+		if !ok {
+			return ErrInvalidArgument
+		}
+		if index < 0 || index > dst.Len()-1 {
+			return ErrOutOfRange
+		}
+		v := reflect.MakeSlice(dst.Type(), 0, dst.Len()-1)
+		v = reflect.AppendSlice(v, dst.Slice(0, index))
+		v = reflect.AppendSlice(v, dst.Slice(index+1, dst.Len()))
+		dst.Set(v)
+	case reflect.Struct:
+		name, ok := key.(string)
+		if !ok {
+			return ErrUnexpectedValue
+		}
+		v, err := structField(dst, name)
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.Zero(v.Type()))
+	default:
+		return ErrUnexpectedValue
+	}
+	return nil
+}
@@ -0,0 +1,215 @@
+package typ
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestSetPath(t *testing.T) {
+	testData := []struct {
+		doc      interface{}
+		value    interface{}
+		keys     []interface{}
+		expected interface{}
+		err      error
+	}{
+		{
+			// create intermediate containers
+			map[string]interface{}{},
+			42,
+			[]interface{}{"users", 1, "id"},
+			map[string]interface{}{"users": []interface{}{nil, map[string]interface{}{"id": 42}}},
+			nil,
+		},
+		{
+			// replace existing value
+			map[string]interface{}{"a": []interface{}{1, 2}},
+			"3",
+			[]interface{}{"a", 1},
+			map[string]interface{}{"a": []interface{}{1, "3"}},
+			nil,
+		},
+		{
+			// convert value to element type
+			map[string][]int{"a": {1}},
+			"42",
+			[]interface{}{"a", 2},
+			map[string][]int{"a": {1, 0, 42}},
+			nil,
+		},
+		{
+			// convert value to element type, can't safely convert
+			map[string][]int8{"a": {1}},
+			300,
+			[]interface{}{"a", 0},
+			map[string][]int8{"a": {1}},
+			ErrConvert,
+		},
+		{
+			// typed intermediate map
+			map[string]map[int]string{},
+			1.5,
+			[]interface{}{"a", 1},
+			map[string]map[int]string{"a": {1: "1.5"}},
+			nil,
+		},
+		{
+			// struct field
+			map[string]interface{}{"s": &StructFieldsType{}},
+			"name",
+			[]interface{}{"s", "name"},
+			map[string]interface{}{"s": &StructFieldsType{Name: "name"}},
+			nil,
+		},
+		{
+			// nil value
+			map[string]interface{}{"a": 1},
+			nil,
+			[]interface{}{"a"},
+			map[string]interface{}{"a": nil},
+			nil,
+		},
+		{
+			// replace root
+			map[string]interface{}{},
+			map[string]interface{}{"a": 1},
+			[]interface{}{},
+			map[string]interface{}{"a": 1},
+			nil,
+		},
+		{
+			// invalid, negative index
+			[]interface{}{},
+			1,
+			[]interface{}{-1},
+			[]interface{}{},
+			ErrOutOfRange,
+		},
+		{
+			// invalid, array index
+			[1]int{},
+			1,
+			[]interface{}{1},
+			[1]int{},
+			ErrOutOfRange,
+		},
+		{
+			// invalid, wrong key type
+			map[string]interface{}{"a": []interface{}{}},
+			1,
+			[]interface{}{"a", "0"},
+			map[string]interface{}{"a": []interface{}{}},
+			ErrInvalidArgument,
+		},
+		{
+			// invalid, wrong key type of map
+			map[string]interface{}{},
+			1,
+			[]interface{}{1},
+			map[string]interface{}{},
+			ErrInvalidArgument,
+		},
+		{
+			// invalid, primitive value in path
+			map[string]interface{}{"a": 1},
+			1,
+			[]interface{}{"a", "b"},
+			map[string]interface{}{"a": 1},
+			ErrUnexpectedValue,
+		},
+		{
+			// invalid, unexported field
+			StructFieldsType{},
+			1,
+			[]interface{}{"unexported"},
+			StructFieldsType{},
+			ErrUnexportedField,
+		},
+	}
+	for _, v := range testData {
+		doc := reflect.New(reflect.TypeOf(v.doc))
+		doc.Elem().Set(reflect.ValueOf(v.doc))
+		err := SetPath(doc.Interface(), v.value, v.keys...)
+		if !errors.Is(err, v.err) || !reflect.DeepEqual(doc.Elem().Interface(), v.expected) {
+			t.Errorf("SetPath(%v, %v, %v) failed, expected (expected == actual) %v == %v, error (%v == %v)",
+				v.doc, v.value, v.keys, v.expected, doc.Elem().Interface(), v.err, err,
+			)
+		}
+	}
+	var doc interface{}
+	if err := SetPath(&doc, 42, "a", 0); err != nil || !reflect.DeepEqual(doc, map[string]interface{}{"a": []interface{}{42}}) {
+		t.Errorf("SetPath(&nil, 42, \"a\", 0) failed, unexpected result %v, error %v", doc, err)
+	}
+	if err := SetPath(doc, 42, "a"); err != ErrInvalidArgument {
+		t.Errorf("SetPath(%v, 42, \"a\") failed, expected error %v instead of %v", doc, ErrInvalidArgument, err)
+	}
+}
+
+func TestDeletePath(t *testing.T) {
+	testData := []struct {
+		doc      interface{}
+		keys     []interface{}
+		expected interface{}
+		err      error
+	}{
+		{
+			map[string]interface{}{"a": map[string]interface{}{"b": 1, "c": 2}},
+			[]interface{}{"a", "b"},
+			map[string]interface{}{"a": map[string]interface{}{"c": 2}},
+			nil,
+		},
+		{
+			map[string]interface{}{"a": []interface{}{1, 2, 3}},
+			[]interface{}{"a", 1},
+			map[string]interface{}{"a": []interface{}{1, 3}},
+			nil,
+		},
+		{
+			[]interface{}{&StructFieldsType{Name: "name"}},
+			[]interface{}{0, "name"},
+			[]interface{}{&StructFieldsType{}},
+			nil,
+		},
+		{
+			map[string]interface{}{"a": []interface{}{1}},
+			[]interface{}{"a", 1},
+			map[string]interface{}{"a": []interface{}{1}},
+			ErrOutOfRange,
+		},
+		{
+			map[string]interface{}{"a": 1},
+			[]interface{}{"b"},
+			map[string]interface{}{"a": 1},
+			ErrOutOfBounds,
+		},
+		{
+			map[string]interface{}{"a": 1},
+			[]interface{}{"b", "c"},
+			map[string]interface{}{"a": 1},
+			ErrOutOfBounds,
+		},
+		{
+			map[string]interface{}{"a": 1},
+			[]interface{}{"a", "b"},
+			map[string]interface{}{"a": 1},
+			ErrUnexpectedValue,
+		},
+		{
+			map[string]interface{}{"a": 1},
+			[]interface{}{},
+			map[string]interface{}{"a": 1},
+			ErrInvalidArgument,
+		},
+	}
+	for _, v := range testData {
+		doc := reflect.New(reflect.TypeOf(v.doc))
+		doc.Elem().Set(reflect.ValueOf(v.doc))
+		err := DeletePath(doc.Interface(), v.keys...)
+		if err != v.err || !reflect.DeepEqual(doc.Elem().Interface(), v.expected) {
+			t.Errorf("DeletePath(%v, %v) failed, expected (expected == actual) %v == %v, error (%v == %v)",
+				v.doc, v.keys, v.expected, doc.Elem().Interface(), v.err, err,
+			)
+		}
+	}
+}
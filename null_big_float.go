@@ -0,0 +1,197 @@
+package typ
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"math/big"
+)
+
+// BigFloatCommon represents a *big.Float that may be null.
+type BigFloatCommon struct {
+	P     *big.Float
+	Error error
+}
+
+// Set saves copy of value into current struct, nil value is saved as not present
+func (n *BigFloatCommon) Set(value *big.Float) {
+	if value == nil {
+		n.P = nil
+		return
+	}
+	n.P = new(big.Float).Copy(value)
+}
+
+// V returns copy of value if it was set, otherwise zero value
+func (n BigFloatCommon) V() *big.Float {
+	if n.P == nil {
+		return new(big.Float)
+	}
+	return new(big.Float).Copy(n.P)
+}
+
+// Present determines whether a value has been set
+func (n BigFloatCommon) Present() bool {
+	return n.P != nil
+}
+
+// Valid determines whether a value has been valid
+func (n BigFloatCommon) Valid() bool {
+	return n.Err() == nil
+}
+
+// Value implements the sql driver Valuer interface.
+// Value is stored as decimal string to keep precision of NUMERIC columns, infinite values can't be stored
+func (n BigFloatCommon) Value() (driver.Value, error) {
+	v := n.V()
+	if v.IsInf() {
+		return nil, ErrConvert
+	}
+	return v.Text('g', -1), nil
+}
+
+// Scan implements the sql Scanner interface.
+func (n *BigFloatCommon) Scan(value interface{}) error {
+	n.P, n.Error = nil, nil
+	if value == nil {
+		return nil
+	}
+	if v, ok := value.([]byte); ok {
+		value = string(v)
+	}
+	v := Of(value).BigFloat(0)
+	if v.Err() != nil {
+		n.Error = v.Err()
+		return n.Err()
+	}
+	n.P = v.V()
+	return nil
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+// Only JSON numbers are accepted, precision is chosen by count of digits
+func (n *BigFloatCommon) UnmarshalJSON(b []byte) error {
+	n.P, n.Error = nil, nil
+	var uv interface{}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&uv); err != nil {
+		n.Error = err
+		return err
+	}
+	if uv == nil {
+		return nil
+	}
+	switch uv.(type) {
+	case json.Number:
+	default:
+		n.Error = ErrConvert
+		return n.Err()
+	}
+	v := Of(uv).BigFloat(0)
+	if v.Err() != nil {
+		n.Error = ErrConvert
+		return n.Err()
+	}
+	n.P = v.V()
+	return nil
+}
+
+// MarshalJSON implements the json Marshaler interface.
+func (n BigFloatCommon) MarshalJSON() ([]byte, error) {
+	v := n.V()
+	if v.IsInf() {
+		return nil, ErrConvert
+	}
+	return []byte(v.Text('g', -1)), nil
+}
+
+// Typ returns new instance with himself value.
+// If current value is invalid, nil *Type returned
+func (n BigFloatCommon) Typ(options ...Option) *Type {
+	if n.Err() != nil {
+		return NewType(nil, n.Err())
+	}
+	return NewType(n.V(), n.Err(), options...)
+}
+
+// Err returns underlying error.
+func (n BigFloatCommon) Err() error {
+	return n.Error
+}
+
+// BigFloatAccessor accessor of *big.Float type.
+type BigFloatAccessor interface {
+	Common
+	V() *big.Float
+	Set(value *big.Float)
+	Clone() BigFloatAccessor
+}
+
+// NullBigFloat represents a *big.Float that may be null.
+type NullBigFloat struct {
+	BigFloatCommon
+}
+
+// Value implements the sql driver Valuer interface.
+func (n NullBigFloat) Value() (driver.Value, error) {
+	if n.Err() != nil || !n.Present() {
+		return nil, n.Err()
+	}
+	return n.BigFloatCommon.Value()
+}
+
+// MarshalJSON implements the json Marshaler interface.
+func (n NullBigFloat) MarshalJSON() ([]byte, error) {
+	if n.Err() != nil || !n.Present() {
+		return json.Marshal(nil)
+	}
+	return n.BigFloatCommon.MarshalJSON()
+}
+
+// Clone returns new instance of NullBigFloat with preserved value & error
+func (n NullBigFloat) Clone() BigFloatAccessor {
+	nv := &NullBigFloat{}
+	nv.Set(n.P)
+	nv.Error = n.Error
+	return nv
+}
+
+// NBigFloat returns NullBigFloat under BigFloatAccessor from *big.Float
+func NBigFloat(value *big.Float) BigFloatAccessor {
+	nv := &NullBigFloat{}
+	nv.Set(value)
+	return nv
+}
+
+// NotNullBigFloat represents a *big.Float that may be null.
+type NotNullBigFloat struct {
+	BigFloatCommon
+}
+
+// Clone returns new instance of NotNullBigFloat with preserved value & error
+func (n NotNullBigFloat) Clone() BigFloatAccessor {
+	nv := &NotNullBigFloat{}
+	nv.Set(n.P)
+	nv.Error = n.Error
+	return nv
+}
+
+// NNBigFloat returns NotNullBigFloat under BigFloatAccessor from *big.Float
+func NNBigFloat(value *big.Float) BigFloatAccessor {
+	nv := &NotNullBigFloat{}
+	nv.Set(value)
+	return nv
+}
+
+// BigFloatSlice returns slice of *big.Float with filled values from slice of BigFloatAccessor
+func BigFloatSlice(null []BigFloatAccessor, valid bool) []*big.Float {
+	slice := make([]*big.Float, 0, len(null))
+	for _, v := range null {
+		if valid && v.Err() != nil {
+			continue
+		}
+		slice = append(slice, v.V())
+	}
+	return slice
+}
@@ -0,0 +1,114 @@
+package typ
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+var (
+	nullBigFloatReflectTypes = []reflect.Type{
+		reflect.TypeOf(&NullBigFloat{}),
+		reflect.TypeOf(&NotNullBigFloat{}),
+	}
+)
+
+func init() {
+	registerNullSuite(nullSuite{
+		types:  nullBigFloatReflectTypes,
+		values: []interface{}{big.NewFloat(1.5), big.NewFloat(-1e300)},
+		wrap: func(value interface{}, to reflect.Type) interface{} {
+			v := new(big.Float).Copy(value.(*big.Float))
+			if to == nullBigFloatReflectTypes[0] {
+				return &NullBigFloat{BigFloatCommon{P: v}}
+			}
+			return &NotNullBigFloat{BigFloatCommon{P: v}}
+		},
+		token: func(value interface{}) ([]byte, error) {
+			return []byte(value.(*big.Float).Text('g', -1)), nil
+		},
+		sql: func(value interface{}) driver.Value {
+			return value.(*big.Float).Text('g', -1)
+		},
+	})
+	matrixSuite.SetComparator(reflect.TypeOf(&big.Float{}), func(a interface{}, b interface{}) bool {
+		return a.(*big.Float).Cmp(b.(*big.Float)) == 0
+	})
+}
+
+func TestNullBigFloat(t *testing.T) {
+	for _, nv := range []interface{}{
+		&NullBigFloat{},
+	} {
+		testMarshalJSON(t, nv)
+		testUnmarshalJSON(t, nv)
+		testScanSQL(t, nv)
+		testValueSQL(t, nv)
+		testTyp(t, nv)
+		testClone(t, nv)
+		testSet(t, nv)
+		testNType(t, nv, NBigFloat)
+	}
+}
+
+func TestNotNullBigFloat(t *testing.T) {
+	for _, nv := range []interface{}{
+		&NotNullBigFloat{},
+	} {
+		testMarshalJSON(t, nv)
+		testUnmarshalJSON(t, nv)
+		testScanSQL(t, nv)
+		testValueSQL(t, nv)
+		testTyp(t, nv)
+		testClone(t, nv)
+		testSet(t, nv)
+		testNType(t, nv, NNBigFloat)
+	}
+}
+
+func TestNullBigFloatJSON(t *testing.T) {
+	pi := "3.14159265358979323846264338327950288"
+	testNullJSON(t, func() interface{} { return &NullBigFloat{} }, func() interface{} { return &NotNullBigFloat{} }, []nullJSONTest{
+		{pi, pi, true, true, pi, pi},
+		{`1.5e300`, "1.5e+300", true, true, `1.5e+300`, `1.5e+300`},
+		{`null`, "0", true, false, `null`, `0`},
+		{`"1.5"`, "0", false, false, `null`, `0`},
+		{`[]`, "0", false, false, `null`, `0`},
+	})
+}
+
+func TestNullBigFloatSQL(t *testing.T) {
+	pi := "3.14159265358979323846264338327950288"
+	nv := &NullBigFloat{}
+	if err := nv.Scan([]byte(pi)); err != nil || nv.V().Text('g', -1) != pi {
+		t.Errorf("NullBigFloat.Scan(%v) failed, expected %v instead of %v, error %v", pi, pi, nv.V(), err)
+	}
+	if sv, err := nv.Value(); sv != pi || err != nil {
+		t.Errorf("NullBigFloat.Value() failed, expected %v instead of %v, error %v", pi, sv, err)
+	}
+	inf := NBigFloat(new(big.Float).SetInf(false))
+	if _, err := inf.(*NullBigFloat).Value(); err == nil {
+		t.Errorf("NullBigFloat.Value() failed, infinite value must returns error")
+	}
+	if _, err := json.Marshal(inf); err == nil {
+		t.Errorf("json.Marshal(NullBigFloat) failed, infinite value must returns error")
+	}
+}
+
+func TestNullBigFloatAccessor(t *testing.T) {
+	f := new(big.Float).SetPrec(100).SetFloat64(0.5)
+	for _, nv := range []BigFloatAccessor{NBigFloat(f), NNBigFloat(f)} {
+		if cv := nv.Clone(); cv.V().Cmp(f) != 0 || cv.V().Prec() != 100 || reflect.TypeOf(cv) != reflect.TypeOf(nv) {
+			t.Errorf("%T.Clone() failed, expected %v instead of %v", nv, f, cv.V())
+		}
+		if tv := nv.Typ().Float(); tv.V() != 0.5 {
+			t.Errorf("%T.Typ().Float() failed, expected 0.5 instead of %v", nv, tv.V())
+		}
+	}
+	ns := []BigFloatAccessor{NBigFloat(f), NNBigFloat(f), &NullBigFloat{BigFloatCommon{Error: ErrDefaultValue}}}
+	if sl := BigFloatSlice(ns, true); len(sl) != len(ns)-1 {
+		t.Errorf("BigFloatSlice(%v, true), slice length not equal", ns)
+	}
+}
@@ -0,0 +1,189 @@
+package typ
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"math/big"
+)
+
+// BigIntCommon represents a *big.Int that may be null.
+type BigIntCommon struct {
+	P     *big.Int
+	Error error
+}
+
+// Set saves copy of value into current struct, nil value is saved as not present
+func (n *BigIntCommon) Set(value *big.Int) {
+	if value == nil {
+		n.P = nil
+		return
+	}
+	n.P = new(big.Int).Set(value)
+}
+
+// V returns copy of value if it was set, otherwise zero value
+func (n BigIntCommon) V() *big.Int {
+	if n.P == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(n.P)
+}
+
+// Present determines whether a value has been set
+func (n BigIntCommon) Present() bool {
+	return n.P != nil
+}
+
+// Valid determines whether a value has been valid
+func (n BigIntCommon) Valid() bool {
+	return n.Err() == nil
+}
+
+// Value implements the sql driver Valuer interface.
+// Value is stored as decimal string to keep precision of NUMERIC columns
+func (n BigIntCommon) Value() (driver.Value, error) {
+	return n.V().String(), nil
+}
+
+// Scan implements the sql Scanner interface.
+func (n *BigIntCommon) Scan(value interface{}) error {
+	n.P, n.Error = nil, nil
+	if value == nil {
+		return nil
+	}
+	if v, ok := value.([]byte); ok {
+		value = string(v)
+	}
+	v := Of(value).BigInt()
+	if v.Err() != nil {
+		n.Error = v.Err()
+		return n.Err()
+	}
+	n.P = v.V()
+	return nil
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+// Only JSON numbers without fractional part are accepted
+func (n *BigIntCommon) UnmarshalJSON(b []byte) error {
+	n.P, n.Error = nil, nil
+	var uv interface{}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&uv); err != nil {
+		n.Error = err
+		return err
+	}
+	if uv == nil {
+		return nil
+	}
+	switch uv.(type) {
+	case json.Number:
+	default:
+		n.Error = ErrConvert
+		return n.Err()
+	}
+	v := Of(uv).BigInt()
+	if v.Err() != nil {
+		n.Error = ErrConvert
+		return n.Err()
+	}
+	n.P = v.V()
+	return nil
+}
+
+// MarshalJSON implements the json Marshaler interface.
+func (n BigIntCommon) MarshalJSON() ([]byte, error) {
+	return []byte(n.V().String()), nil
+}
+
+// Typ returns new instance with himself value.
+// If current value is invalid, nil *Type returned
+func (n BigIntCommon) Typ(options ...Option) *Type {
+	if n.Err() != nil {
+		return NewType(nil, n.Err())
+	}
+	return NewType(n.V(), n.Err(), options...)
+}
+
+// Err returns underlying error.
+func (n BigIntCommon) Err() error {
+	return n.Error
+}
+
+// BigIntAccessor accessor of *big.Int type.
+type BigIntAccessor interface {
+	Common
+	V() *big.Int
+	Set(value *big.Int)
+	Clone() BigIntAccessor
+}
+
+// NullBigInt represents a *big.Int that may be null.
+type NullBigInt struct {
+	BigIntCommon
+}
+
+// Value implements the sql driver Valuer interface.
+func (n NullBigInt) Value() (driver.Value, error) {
+	if n.Err() != nil || !n.Present() {
+		return nil, n.Err()
+	}
+	return n.BigIntCommon.Value()
+}
+
+// MarshalJSON implements the json Marshaler interface.
+func (n NullBigInt) MarshalJSON() ([]byte, error) {
+	if n.Err() != nil || !n.Present() {
+		return json.Marshal(nil)
+	}
+	return n.BigIntCommon.MarshalJSON()
+}
+
+// Clone returns new instance of NullBigInt with preserved value & error
+func (n NullBigInt) Clone() BigIntAccessor {
+	nv := &NullBigInt{}
+	nv.Set(n.P)
+	nv.Error = n.Error
+	return nv
+}
+
+// NBigInt returns NullBigInt under BigIntAccessor from *big.Int
+func NBigInt(value *big.Int) BigIntAccessor {
+	nv := &NullBigInt{}
+	nv.Set(value)
+	return nv
+}
+
+// NotNullBigInt represents a *big.Int that may be null.
+type NotNullBigInt struct {
+	BigIntCommon
+}
+
+// Clone returns new instance of NotNullBigInt with preserved value & error
+func (n NotNullBigInt) Clone() BigIntAccessor {
+	nv := &NotNullBigInt{}
+	nv.Set(n.P)
+	nv.Error = n.Error
+	return nv
+}
+
+// NNBigInt returns NotNullBigInt under BigIntAccessor from *big.Int
+func NNBigInt(value *big.Int) BigIntAccessor {
+	nv := &NotNullBigInt{}
+	nv.Set(value)
+	return nv
+}
+
+// BigIntSlice returns slice of *big.Int with filled values from slice of BigIntAccessor
+func BigIntSlice(null []BigIntAccessor, valid bool) []*big.Int {
+	slice := make([]*big.Int, 0, len(null))
+	for _, v := range null {
+		if valid && v.Err() != nil {
+			continue
+		}
+		slice = append(slice, v.V())
+	}
+	return slice
+}
@@ -0,0 +1,134 @@
+package typ
+
+import (
+	"database/sql/driver"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+var (
+	nullBigIntReflectTypes = []reflect.Type{
+		reflect.TypeOf(&NullBigInt{}),
+		reflect.TypeOf(&NotNullBigInt{}),
+	}
+)
+
+func init() {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	registerNullSuite(nullSuite{
+		types:  nullBigIntReflectTypes,
+		values: []interface{}{huge, big.NewInt(-42)},
+		wrap: func(value interface{}, to reflect.Type) interface{} {
+			v := new(big.Int).Set(value.(*big.Int))
+			if to == nullBigIntReflectTypes[0] {
+				return &NullBigInt{BigIntCommon{P: v}}
+			}
+			return &NotNullBigInt{BigIntCommon{P: v}}
+		},
+		token: func(value interface{}) ([]byte, error) {
+			return []byte(value.(*big.Int).String()), nil
+		},
+		sql: func(value interface{}) driver.Value {
+			return value.(*big.Int).String()
+		},
+	})
+	matrixSuite.SetComparator(reflect.TypeOf(&big.Int{}), func(a interface{}, b interface{}) bool {
+		return a.(*big.Int).Cmp(b.(*big.Int)) == 0
+	})
+}
+
+func TestNullBigInt(t *testing.T) {
+	for _, nv := range []interface{}{
+		&NullBigInt{},
+	} {
+		testMarshalJSON(t, nv)
+		testUnmarshalJSON(t, nv)
+		testScanSQL(t, nv)
+		testValueSQL(t, nv)
+		testTyp(t, nv)
+		testClone(t, nv)
+		testSet(t, nv)
+		testNType(t, nv, NBigInt)
+	}
+}
+
+func TestNotNullBigInt(t *testing.T) {
+	for _, nv := range []interface{}{
+		&NotNullBigInt{},
+	} {
+		testMarshalJSON(t, nv)
+		testUnmarshalJSON(t, nv)
+		testScanSQL(t, nv)
+		testValueSQL(t, nv)
+		testTyp(t, nv)
+		testClone(t, nv)
+		testSet(t, nv)
+		testNType(t, nv, NNBigInt)
+	}
+}
+
+func TestNullBigIntJSON(t *testing.T) {
+	testNullJSON(t, func() interface{} { return &NullBigInt{} }, func() interface{} { return &NotNullBigInt{} }, []nullJSONTest{
+		{`123456789012345678901234567890`, "123456789012345678901234567890", true, true, `123456789012345678901234567890`, `123456789012345678901234567890`},
+		{`-42`, "-42", true, true, `-42`, `-42`},
+		{`1e3`, "1000", true, true, `1000`, `1000`},
+		{`null`, "0", true, false, `null`, `0`},
+		{`1.5`, "0", false, false, `null`, `0`},
+		{`"42"`, "0", false, false, `null`, `0`},
+	})
+}
+
+func TestNullBigIntSQL(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	testData := []struct {
+		value         interface{}
+		expected      string
+		expectedValid bool
+		present       bool
+	}{
+		{[]byte("123456789012345678901234567890"), "123456789012345678901234567890", true, true},
+		{int64(-7), "-7", true, true},
+		{"12.00", "12", true, true},
+		{"12.5", "0", false, false},
+		{nil, "0", true, false},
+	}
+	for _, v := range testData {
+		nv := &NullBigInt{}
+		err := nv.Scan(v.value)
+		if nv.V().String() != v.expected || nv.Valid() != v.expectedValid || nv.Present() != v.present || (err == nil) != v.expectedValid {
+			t.Errorf("NullBigInt.Scan(%v) failed, %s", v.value, errNull{
+				v.expected, v.expectedValid, nil, nv.V(), nv.Valid(), err,
+			})
+		}
+	}
+	if sv, err := NBigInt(huge).(*NullBigInt).Value(); sv != huge.String() || err != nil {
+		t.Errorf("NullBigInt.Value() failed, expected %v instead of %v, error %v", huge, sv, err)
+	}
+	if sv, err := (NullBigInt{}).Value(); sv != nil || err != nil {
+		t.Errorf("NullBigInt.Value() failed, expected nil instead of %v, error %v", sv, err)
+	}
+}
+
+func TestNullBigIntAccessor(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	for _, nv := range []BigIntAccessor{NBigInt(huge), NNBigInt(huge)} {
+		if cv := nv.Clone(); cv.V().Cmp(huge) != 0 || reflect.TypeOf(cv) != reflect.TypeOf(nv) {
+			t.Errorf("%T.Clone() failed, expected %v instead of %v", nv, huge, cv.V())
+		}
+		nv.V().SetInt64(1)
+		if nv.V().Cmp(huge) != 0 {
+			t.Errorf("%T.V() failed, value must be copied", nv)
+		}
+		if tv := nv.Typ().String(); tv.V() != huge.String() {
+			t.Errorf("%T.Typ().String() failed, expected %v instead of %v", nv, huge, tv.V())
+		}
+	}
+	ns := []BigIntAccessor{NBigInt(huge), NNBigInt(nil), &NullBigInt{BigIntCommon{Error: ErrDefaultValue}}}
+	if sl := BigIntSlice(ns, false); len(sl) != len(ns) {
+		t.Errorf("BigIntSlice(%v, false), slice length not equal", ns)
+	}
+	if sl := BigIntSlice(ns, true); len(sl) != len(ns)-1 {
+		t.Errorf("BigIntSlice(%v, true), slice length not equal", ns)
+	}
+}
@@ -0,0 +1,189 @@
+package typ
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"math/big"
+)
+
+// BigRatCommon represents a *big.Rat that may be null.
+type BigRatCommon struct {
+	P     *big.Rat
+	Error error
+}
+
+// Set saves copy of value into current struct, nil value is saved as not present
+func (n *BigRatCommon) Set(value *big.Rat) {
+	if value == nil {
+		n.P = nil
+		return
+	}
+	n.P = new(big.Rat).Set(value)
+}
+
+// V returns copy of value if it was set, otherwise zero value
+func (n BigRatCommon) V() *big.Rat {
+	if n.P == nil {
+		return new(big.Rat)
+	}
+	return new(big.Rat).Set(n.P)
+}
+
+// Present determines whether a value has been set
+func (n BigRatCommon) Present() bool {
+	return n.P != nil
+}
+
+// Valid determines whether a value has been valid
+func (n BigRatCommon) Valid() bool {
+	return n.Err() == nil
+}
+
+// Value implements the sql driver Valuer interface.
+// Value is stored as fraction string, e.g. "1/3", integers are stored without denominator
+func (n BigRatCommon) Value() (driver.Value, error) {
+	return n.V().RatString(), nil
+}
+
+// Scan implements the sql Scanner interface.
+func (n *BigRatCommon) Scan(value interface{}) error {
+	n.P, n.Error = nil, nil
+	if value == nil {
+		return nil
+	}
+	if v, ok := value.([]byte); ok {
+		value = string(v)
+	}
+	v := Of(value).BigRat()
+	if v.Err() != nil {
+		n.Error = v.Err()
+		return n.Err()
+	}
+	n.P = v.V()
+	return nil
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+// JSON numbers and fraction strings, e.g. "1/3", are accepted
+func (n *BigRatCommon) UnmarshalJSON(b []byte) error {
+	n.P, n.Error = nil, nil
+	var uv interface{}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&uv); err != nil {
+		n.Error = err
+		return err
+	}
+	if uv == nil {
+		return nil
+	}
+	switch uv.(type) {
+	case json.Number, string:
+	default:
+		n.Error = ErrConvert
+		return n.Err()
+	}
+	v := Of(uv).BigRat()
+	if v.Err() != nil {
+		n.Error = ErrConvert
+		return n.Err()
+	}
+	n.P = v.V()
+	return nil
+}
+
+// MarshalJSON implements the json Marshaler interface.
+func (n BigRatCommon) MarshalJSON() ([]byte, error) {
+	return json.Marshal(n.V().RatString())
+}
+
+// Typ returns new instance with himself value.
+// If current value is invalid, nil *Type returned
+func (n BigRatCommon) Typ(options ...Option) *Type {
+	if n.Err() != nil {
+		return NewType(nil, n.Err())
+	}
+	return NewType(n.V(), n.Err(), options...)
+}
+
+// Err returns underlying error.
+func (n BigRatCommon) Err() error {
+	return n.Error
+}
+
+// BigRatAccessor accessor of *big.Rat type.
+type BigRatAccessor interface {
+	Common
+	V() *big.Rat
+	Set(value *big.Rat)
+	Clone() BigRatAccessor
+}
+
+// NullBigRat represents a *big.Rat that may be null.
+type NullBigRat struct {
+	BigRatCommon
+}
+
+// Value implements the sql driver Valuer interface.
+func (n NullBigRat) Value() (driver.Value, error) {
+	if n.Err() != nil || !n.Present() {
+		return nil, n.Err()
+	}
+	return n.BigRatCommon.Value()
+}
+
+// MarshalJSON implements the json Marshaler interface.
+func (n NullBigRat) MarshalJSON() ([]byte, error) {
+	if n.Err() != nil || !n.Present() {
+		return json.Marshal(nil)
+	}
+	return n.BigRatCommon.MarshalJSON()
+}
+
+// Clone returns new instance of NullBigRat with preserved value & error
+func (n NullBigRat) Clone() BigRatAccessor {
+	nv := &NullBigRat{}
+	nv.Set(n.P)
+	nv.Error = n.Error
+	return nv
+}
+
+// NBigRat returns NullBigRat under BigRatAccessor from *big.Rat
+func NBigRat(value *big.Rat) BigRatAccessor {
+	nv := &NullBigRat{}
+	nv.Set(value)
+	return nv
+}
+
+// NotNullBigRat represents a *big.Rat that may be null.
+type NotNullBigRat struct {
+	BigRatCommon
+}
+
+// Clone returns new instance of NotNullBigRat with preserved value & error
+func (n NotNullBigRat) Clone() BigRatAccessor {
+	nv := &NotNullBigRat{}
+	nv.Set(n.P)
+	nv.Error = n.Error
+	return nv
+}
+
+// NNBigRat returns NotNullBigRat under BigRatAccessor from *big.Rat
+func NNBigRat(value *big.Rat) BigRatAccessor {
+	nv := &NotNullBigRat{}
+	nv.Set(value)
+	return nv
+}
+
+// BigRatSlice returns slice of *big.Rat with filled values from slice of BigRatAccessor
+func BigRatSlice(null []BigRatAccessor, valid bool) []*big.Rat {
+	slice := make([]*big.Rat, 0, len(null))
+	for _, v := range null {
+		if valid && v.Err() != nil {
+			continue
+		}
+		slice = append(slice, v.V())
+	}
+	return slice
+}
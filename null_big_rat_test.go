@@ -0,0 +1,106 @@
+package typ
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+var (
+	nullBigRatReflectTypes = []reflect.Type{
+		reflect.TypeOf(&NullBigRat{}),
+		reflect.TypeOf(&NotNullBigRat{}),
+	}
+)
+
+func init() {
+	registerNullSuite(nullSuite{
+		types:  nullBigRatReflectTypes,
+		values: []interface{}{big.NewRat(1, 3), big.NewRat(-7, 1)},
+		wrap: func(value interface{}, to reflect.Type) interface{} {
+			v := new(big.Rat).Set(value.(*big.Rat))
+			if to == nullBigRatReflectTypes[0] {
+				return &NullBigRat{BigRatCommon{P: v}}
+			}
+			return &NotNullBigRat{BigRatCommon{P: v}}
+		},
+		token: func(value interface{}) ([]byte, error) {
+			return json.Marshal(value.(*big.Rat).RatString())
+		},
+		sql: func(value interface{}) driver.Value {
+			return value.(*big.Rat).RatString()
+		},
+	})
+	matrixSuite.SetComparator(reflect.TypeOf(&big.Rat{}), func(a interface{}, b interface{}) bool {
+		return a.(*big.Rat).Cmp(b.(*big.Rat)) == 0
+	})
+}
+
+func TestNullBigRat(t *testing.T) {
+	for _, nv := range []interface{}{
+		&NullBigRat{},
+	} {
+		testMarshalJSON(t, nv)
+		testUnmarshalJSON(t, nv)
+		testScanSQL(t, nv)
+		testValueSQL(t, nv)
+		testTyp(t, nv)
+		testClone(t, nv)
+		testSet(t, nv)
+		testNType(t, nv, NBigRat)
+	}
+}
+
+func TestNotNullBigRat(t *testing.T) {
+	for _, nv := range []interface{}{
+		&NotNullBigRat{},
+	} {
+		testMarshalJSON(t, nv)
+		testUnmarshalJSON(t, nv)
+		testScanSQL(t, nv)
+		testValueSQL(t, nv)
+		testTyp(t, nv)
+		testClone(t, nv)
+		testSet(t, nv)
+		testNType(t, nv, NNBigRat)
+	}
+}
+
+func TestNullBigRatJSON(t *testing.T) {
+	testNullJSON(t, func() interface{} { return &NullBigRat{} }, func() interface{} { return &NotNullBigRat{} }, []nullJSONTest{
+		{`"1/3"`, "1/3", true, true, `"1/3"`, `"1/3"`},
+		{`0.25`, "1/4", true, true, `"1/4"`, `"1/4"`},
+		{`"7"`, "7/1", true, true, `"7"`, `"7"`},
+		{`null`, "0/1", true, false, `null`, `"0"`},
+		{`"1/0"`, "0/1", false, false, `null`, `"0"`},
+		{`true`, "0/1", false, false, `null`, `"0"`},
+	})
+}
+
+func TestNullBigRatSQL(t *testing.T) {
+	nv := &NullBigRat{}
+	if err := nv.Scan([]byte("2/6")); err != nil || nv.V().RatString() != "1/3" {
+		t.Errorf("NullBigRat.Scan(2/6) failed, expected 1/3 instead of %v, error %v", nv.V(), err)
+	}
+	if sv, err := nv.Value(); sv != "1/3" || err != nil {
+		t.Errorf("NullBigRat.Value() failed, expected 1/3 instead of %v, error %v", sv, err)
+	}
+}
+
+func TestNullBigRatAccessor(t *testing.T) {
+	r := big.NewRat(1, 3)
+	for _, nv := range []BigRatAccessor{NBigRat(r), NNBigRat(r)} {
+		if cv := nv.Clone(); cv.V().Cmp(r) != 0 || reflect.TypeOf(cv) != reflect.TypeOf(nv) {
+			t.Errorf("%T.Clone() failed, expected %v instead of %v", nv, r, cv.V())
+		}
+		if tv := nv.Typ().BigRat(); tv.V().Cmp(r) != 0 {
+			t.Errorf("%T.Typ().BigRat() failed, expected %v instead of %v", nv, r, tv.V())
+		}
+	}
+	ns := []BigRatAccessor{NBigRat(r), NNBigRat(r), &NullBigRat{BigRatCommon{Error: ErrDefaultValue}}}
+	if sl := BigRatSlice(ns, true); len(sl) != len(ns)-1 {
+		t.Errorf("BigRatSlice(%v, true), slice length not equal", ns)
+	}
+}
@@ -6,9 +6,12 @@ import (
 )
 
 // BoolCommon represents a bool with pointer and error.
+// Words sets name of vocabulary registered by RegisterBoolVocabulary,
+// strings from SQL & JSON are converted by it if it is set, e.g. "yes" or "off"
 type BoolCommon struct {
 	P     *bool
 	Error error
+	Words string
 }
 
 // Set saves value into current struct
@@ -45,6 +48,14 @@ func (n *BoolCommon) Scan(value interface{}) error {
 	if value == nil {
 		return nil
 	}
+	if n.Words != "" {
+		switch v := value.(type) {
+		case []byte:
+			return n.setWord(string(v))
+		case string:
+			return n.setWord(v)
+		}
+	}
 	v := Of(value).Bool().V()
 	n.P = &v
 	return nil
@@ -61,6 +72,9 @@ func (n *BoolCommon) UnmarshalJSON(b []byte) error {
 	if uv == nil {
 		return nil
 	}
+	if word, ok := uv.(string); ok && n.Words != "" {
+		return n.setWord(word)
+	}
 	v, ok := uv.(bool)
 	if !ok {
 		n.Error = ErrConvert
@@ -70,6 +84,17 @@ func (n *BoolCommon) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// Set value from word of vocabularies
+func (n *BoolCommon) setWord(word string) error {
+	v := StringBoolHumanize(word, BoolWords(n.Words))
+	if v.Err() != nil {
+		n.Error = v.Err()
+		return n.Err()
+	}
+	n.Set(v.V())
+	return nil
+}
+
 // MarshalJSON implements the json Marshaler interface.
 func (n BoolCommon) MarshalJSON() ([]byte, error) {
 	return json.Marshal(n.V())
@@ -124,7 +149,7 @@ func (n NullBool) Clone() BoolAccessor {
 	if n.Present() {
 		nv.Set(n.V())
 	}
-	nv.Error = n.Error
+	nv.Error, nv.Words = n.Error, n.Words
 	return nv
 }
 
@@ -144,7 +169,7 @@ func (n NotNullBool) Clone() BoolAccessor {
 	if n.Present() {
 		nv.Set(n.V())
 	}
-	nv.Error = n.Error
+	nv.Error, nv.Words = n.Error, n.Words
 	return nv
 }
 
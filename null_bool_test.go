@@ -3,6 +3,7 @@ package typ
 import (
 	"database/sql/driver"
 	"encoding/json"
+	"errors"
 	"reflect"
 	"testing"
 )
@@ -113,3 +114,58 @@ func TestNullBoolSlice(t *testing.T) {
 		t.Errorf("NullBoolSlice(%v, true), slice length not equal", ns)
 	}
 }
+
+func TestNullBoolWords(t *testing.T) {
+	testData := []struct {
+		value         interface{}
+		expected      bool
+		expectedValid bool
+		present       bool
+	}{
+		{"yes", true, true, true},
+		{[]byte("OFF"), false, true, true},
+		{"maybe", false, false, false},
+		{int64(1), true, true, true},
+		{nil, false, true, false},
+	}
+	for _, v := range testData {
+		nv := &NullBool{BoolCommon{Words: "en"}}
+		err := nv.Scan(v.value)
+		if nv.V() != v.expected || nv.Valid() != v.expectedValid || nv.Present() != v.present || (err == nil) != v.expectedValid {
+			t.Errorf("NullBool.Scan(%v) failed, %s", v.value, errNull{
+				v.expected, v.expectedValid, nil, nv.V(), nv.Valid(), err,
+			})
+		}
+	}
+	for _, v := range []struct {
+		json          string
+		words         string
+		expected      bool
+		expectedValid bool
+	}{
+		{`"on"`, "en", true, true},
+		{`"N"`, "en", false, true},
+		{`true`, "en", true, true},
+		{`"maybe"`, "en", false, false},
+		{`"on"`, "", false, false},
+		{`"on"`, "unknown", false, false},
+	} {
+		nv := &NotNullBool{BoolCommon{Words: v.words}}
+		err := json.Unmarshal([]byte(v.json), nv)
+		if nv.V() != v.expected || nv.Valid() != v.expectedValid || (err == nil) != v.expectedValid {
+			t.Errorf("json.Unmarshal(%s) to NotNullBool failed, %s", v.json, errNull{
+				v.expected, v.expectedValid, nil, nv.V(), nv.Valid(), err,
+			})
+		}
+	}
+	nv := &NullBool{BoolCommon{Words: "en"}}
+	if err := nv.Scan("maybe"); !errors.Is(err, ErrUnexpectedValue) {
+		t.Errorf("NullBool.Scan(maybe) failed, expected error %v instead of %v", ErrUnexpectedValue, err)
+	}
+	if c := nv.Clone().(*NullBool); c.Words != nv.Words {
+		t.Errorf("NullBool.Clone() failed, expected words %v instead of %v", nv.Words, c.Words)
+	}
+	if (NullBool{BoolCommon{Words: "en"}}) != (NullBool{BoolCommon{Words: "en"}}) {
+		t.Error("NullBool must be comparable")
+	}
+}
@@ -0,0 +1,181 @@
+package typ
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+)
+
+// ClockCommon represents a Clock that may be null.
+type ClockCommon struct {
+	P     *Clock
+	Error error
+}
+
+// Set saves value into current struct
+func (n *ClockCommon) Set(value Clock) {
+	n.P = &value
+}
+
+// V returns value of underlying type if it was set, otherwise default value
+func (n ClockCommon) V() Clock {
+	if n.P == nil {
+		return Clock{}
+	}
+	return *n.P
+}
+
+// Present determines whether a value has been set
+func (n ClockCommon) Present() bool {
+	return n.P != nil
+}
+
+// Valid determines whether a value has been valid
+func (n ClockCommon) Valid() bool {
+	return n.Err() == nil
+}
+
+// Value implements the sql driver Valuer interface.
+// Clock is stored as string in format "15:04:05.999999999"
+func (n ClockCommon) Value() (driver.Value, error) {
+	return n.V().String(), nil
+}
+
+// Scan implements the sql Scanner interface.
+// Strings & bytes are parsed as time of day or timestamps, clock of time.Time is taken in its location
+func (n *ClockCommon) Scan(value interface{}) error {
+	n.P, n.Error = nil, nil
+	if value == nil {
+		return nil
+	}
+	if v, ok := value.([]byte); ok {
+		value = string(v)
+	}
+	v := Of(value).Clock()
+	if v.Err() != nil {
+		n.Error = v.Err()
+		return n.Err()
+	}
+	n.Set(v.V())
+	return nil
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+func (n *ClockCommon) UnmarshalJSON(b []byte) error {
+	n.P, n.Error = nil, nil
+	var uv interface{}
+	if err := json.NewDecoder(bytes.NewReader(b)).Decode(&uv); err != nil {
+		n.Error = err
+		return err
+	}
+	if uv == nil {
+		return nil
+	}
+	s, ok := uv.(string)
+	if !ok {
+		n.Error = ErrConvert
+		return n.Err()
+	}
+	v, err := ParseClock(s)
+	if err != nil {
+		n.Error = ErrConvert
+		return n.Err()
+	}
+	n.Set(v)
+	return nil
+}
+
+// MarshalJSON implements the json Marshaler interface.
+func (n ClockCommon) MarshalJSON() ([]byte, error) {
+	return json.Marshal(n.V().String())
+}
+
+// Typ returns new instance with himself value.
+// If current value is invalid, nil *Type returned
+func (n ClockCommon) Typ(options ...Option) *Type {
+	if n.Err() != nil {
+		return NewType(nil, n.Err())
+	}
+	return NewType(n.V(), n.Err(), options...)
+}
+
+// Err returns underlying error.
+func (n ClockCommon) Err() error {
+	return n.Error
+}
+
+// ClockAccessor accessor of Clock type.
+type ClockAccessor interface {
+	Common
+	V() Clock
+	Set(value Clock)
+	Clone() ClockAccessor
+}
+
+// NullClock represents a Clock that may be null.
+type NullClock struct {
+	ClockCommon
+}
+
+// Value implements the sql driver Valuer interface.
+func (n NullClock) Value() (driver.Value, error) {
+	if n.Err() != nil || !n.Present() {
+		return nil, n.Err()
+	}
+	return n.ClockCommon.Value()
+}
+
+// MarshalJSON implements the json Marshaler interface.
+func (n NullClock) MarshalJSON() ([]byte, error) {
+	if n.Err() != nil || !n.Present() {
+		return json.Marshal(nil)
+	}
+	return n.ClockCommon.MarshalJSON()
+}
+
+// Clone returns new instance of NullClock with preserved value & error
+func (n NullClock) Clone() ClockAccessor {
+	nv := &NullClock{}
+	if n.Present() {
+		nv.Set(n.V())
+	}
+	nv.Error = n.Error
+	return nv
+}
+
+// NClock returns NullClock under ClockAccessor from Clock
+func NClock(value Clock) ClockAccessor {
+	return &NullClock{ClockCommon{P: &value}}
+}
+
+// NotNullClock represents a Clock that may be null.
+type NotNullClock struct {
+	ClockCommon
+}
+
+// Clone returns new instance of NotNullClock with preserved value & error
+func (n NotNullClock) Clone() ClockAccessor {
+	nv := &NotNullClock{}
+	if n.Present() {
+		nv.Set(n.V())
+	}
+	nv.Error = n.Error
+	return nv
+}
+
+// NNClock returns NotNullClock under ClockAccessor from Clock
+func NNClock(value Clock) ClockAccessor {
+	return &NotNullClock{ClockCommon{P: &value}}
+}
+
+// ClockSlice returns slice of Clock with filled values from slice of ClockAccessor
+func ClockSlice(null []ClockAccessor, valid bool) []Clock {
+	slice := make([]Clock, 0, len(null))
+	for _, v := range null {
+		if valid && v.Err() != nil {
+			continue
+		}
+		slice = append(slice, v.V())
+	}
+	return slice
+}
@@ -0,0 +1,126 @@
+package typ
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var (
+	nullClockReflectTypes = []reflect.Type{
+		reflect.TypeOf(&NullClock{}),
+		reflect.TypeOf(&NotNullClock{}),
+	}
+)
+
+func init() {
+	registerNullSuite(nullSuite{
+		types:  nullClockReflectTypes,
+		values: []interface{}{Clock{22, 13, 20, 0}, Clock{0, 0, 0, 125000000}},
+		wrap: func(value interface{}, to reflect.Type) interface{} {
+			v := value.(Clock)
+			if to == nullClockReflectTypes[0] {
+				return &NullClock{ClockCommon{P: &v}}
+			}
+			return &NotNullClock{ClockCommon{P: &v}}
+		},
+		token: func(value interface{}) ([]byte, error) {
+			return json.Marshal(value.(Clock).String())
+		},
+		sql: func(value interface{}) driver.Value {
+			return value.(Clock).String()
+		},
+	})
+}
+
+func TestNullClock(t *testing.T) {
+	for _, nv := range []interface{}{
+		&NullClock{},
+	} {
+		testMarshalJSON(t, nv)
+		testUnmarshalJSON(t, nv)
+		testScanSQL(t, nv)
+		testValueSQL(t, nv)
+		testTyp(t, nv)
+		testClone(t, nv)
+		testSet(t, nv)
+		testNType(t, nv, NClock)
+	}
+}
+
+func TestNotNullClock(t *testing.T) {
+	for _, nv := range []interface{}{
+		&NotNullClock{},
+	} {
+		testMarshalJSON(t, nv)
+		testUnmarshalJSON(t, nv)
+		testScanSQL(t, nv)
+		testValueSQL(t, nv)
+		testTyp(t, nv)
+		testClone(t, nv)
+		testSet(t, nv)
+		testNType(t, nv, NNClock)
+	}
+}
+
+func TestNullClockJSON(t *testing.T) {
+	testNullJSON(t, func() interface{} { return &NullClock{} }, func() interface{} { return &NotNullClock{} }, []nullJSONTest{
+		{`"22:13:20"`, "22:13:20", true, true, `"22:13:20"`, `"22:13:20"`},
+		{`"22:13:20.125"`, "22:13:20.125", true, true, `"22:13:20.125"`, `"22:13:20.125"`},
+		{`"22:13"`, "22:13:00", true, true, `"22:13:00"`, `"22:13:00"`},
+		{`null`, "00:00:00", true, false, `null`, `"00:00:00"`},
+		{`"24:00:00"`, "00:00:00", false, false, `null`, `"00:00:00"`},
+		{`80000`, "00:00:00", false, false, `null`, `"00:00:00"`},
+	})
+}
+
+func TestNullClockScan(t *testing.T) {
+	c := Clock{22, 13, 20, 0}
+	scanData := []struct {
+		value         interface{}
+		expected      Clock
+		expectedValid bool
+		present       bool
+	}{
+		{[]byte("22:13:20"), c, true, true},
+		{"22:13:20.000001", Clock{22, 13, 20, 1000}, true, true},
+		{time.Date(0, 1, 1, 22, 13, 20, 0, time.UTC), c, true, true},
+		{nil, Clock{}, true, false},
+		{"838:59:59", Clock{}, false, false},
+	}
+	for _, v := range scanData {
+		nv := &NullClock{}
+		err := nv.Scan(v.value)
+		if nv.V() != v.expected || nv.Valid() != v.expectedValid || nv.Present() != v.present || (err == nil) != v.expectedValid {
+			t.Errorf("NullClock.Scan(%v) failed, %s", v.value, errNull{
+				v.expected, v.expectedValid, nil, nv.V(), nv.Valid(), err,
+			})
+		}
+	}
+	if sv, err := NClock(c).(*NullClock).Value(); sv != "22:13:20" || err != nil {
+		t.Errorf("NullClock.Value() failed, expected 22:13:20 instead of %v, error %v", sv, err)
+	}
+	if sv, err := (NullClock{}).Value(); sv != nil || err != nil {
+		t.Errorf("NullClock.Value() failed, expected nil instead of %v, error %v", sv, err)
+	}
+	for _, nv := range []ClockAccessor{NClock(c), NNClock(c)} {
+		if cv := nv.Clone(); cv.V() != c || reflect.TypeOf(cv) != reflect.TypeOf(nv) {
+			t.Errorf("%T.Clone() failed, expected %v instead of %v", nv, c, cv.V())
+		}
+		if tv := nv.Typ().Clock(); tv.V() != c {
+			t.Errorf("%T.Typ().Clock() failed, expected %v instead of %v", nv, c, tv.V())
+		}
+	}
+	if nv := NTime(time.Date(2023, 11, 14, 22, 13, 20, 0, time.UTC)).Typ().Clock(); nv.V() != c {
+		t.Errorf("NullTime.Typ().Clock() failed, expected %v instead of %v", c, nv.V())
+	}
+	ns := []ClockAccessor{NClock(c), NNClock(c), &NullClock{ClockCommon{Error: ErrDefaultValue}}}
+	if sl := ClockSlice(ns, false); len(sl) != len(ns) {
+		t.Errorf("ClockSlice(%v, false), slice length not equal", ns)
+	}
+	if sl := ClockSlice(ns, true); len(sl) != len(ns)-1 {
+		t.Errorf("ClockSlice(%v, true), slice length not equal", ns)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"fmt"
 	"reflect"
 	"testing"
 )
@@ -16,6 +17,101 @@ var (
 	sqlValueReflectType = reflect.TypeOf(SQLValueType{})
 )
 
+// nullSuite describes null types of underlying type registered in matrixSuite
+type nullSuite struct {
+	// types are &Null*{} & &NotNull*{} types in this order
+	types []reflect.Type
+	// values are test data of underlying type
+	values []interface{}
+	// wrap returns &Null*{} or &NotNull*{} of type to with value
+	wrap func(value interface{}, to reflect.Type) interface{}
+	// token returns expected JSON of value
+	token func(value interface{}) ([]byte, error)
+	// sql returns expected SQL value of value
+	sql func(value interface{}) driver.Value
+}
+
+// Register null types, test data of underlying type & converters in matrixSuite
+func registerNullSuite(s nullSuite) {
+	rt := reflect.TypeOf(s.values[0])
+	for _, typ := range s.types {
+		matrixSuite.Register(typ, []dataItem{
+			{reflect.New(typ.Elem()), nil},
+		})
+	}
+	var data []dataItem
+	for _, v := range s.values {
+		data = append(data, dataItem{reflect.ValueOf(v), nil})
+	}
+	matrixSuite.Register(rt, data)
+	// Converters
+	// - from &Null*{} to JSONToken
+	matrixSuite.SetConverters(s.types, jsonTokenReflectTypes, func(from interface{}, to reflect.Type, opts ...interface{}) (interface{}, bool) {
+		nv := testGetNullIfaceValue(from)
+		present := nv.present || reflect.TypeOf(from) != s.types[0]
+		b, err := s.token(nv.value)
+		if !nv.valid || !present {
+			b, err = []byte("null"), nil
+		}
+		return JSONToken{from, rt, b, err}, err == nil
+	})
+	matrixSuite.SetConverters([]reflect.Type{rt}, s.types, func(from interface{}, to reflect.Type, opts ...interface{}) (interface{}, bool) {
+		return s.wrap(from, to), true
+	})
+	matrixSuite.SetConverter(rt, sqlValueReflectType, func(from interface{}, to reflect.Type, opts ...interface{}) (interface{}, bool) {
+		return SQLValueType{s.sql(from), from}, true
+	})
+	// - from &Null*{} to SQLValueType
+	matrixSuite.SetConverters(s.types, sqlValueReflectTypes, func(from interface{}, to reflect.Type, opts ...interface{}) (interface{}, bool) {
+		nv := testGetNullIfaceValue(from)
+		if !nv.valid || nv.value == nil {
+			return SQLValueType{}, true
+		}
+		cv, valid, _ := matrixSuite.Convert(nv.value, to)
+		if !valid {
+			return SQLValueType{}, false
+		}
+		return cv, driver.IsValue(cv.(SQLValueType).SQLValue)
+	})
+	// For other types
+	matrixSuite.SetConverters(interfaceReflectTypes, s.types, func(from interface{}, to reflect.Type, opts ...interface{}) (interface{}, bool) {
+		return nil, false
+	})
+}
+
+// nullJSONTest is a test case of JSON unmarshaling & marshaling of null types
+type nullJSONTest struct {
+	json          string
+	expected      string
+	expectedValid bool
+	present       bool
+	marshal       string
+	marshalNN     string
+}
+
+// Unmarshal JSON of test cases to values returned by newNull & newNotNull, then marshal them back.
+// Expected value is compared with value of V() formatted by fmt.Sprint
+func testNullJSON(t *testing.T, newNull, newNotNull func() interface{}, testData []nullJSONTest) {
+	for _, v := range testData {
+		nv := newNull()
+		err := json.Unmarshal([]byte(v.json), nv)
+		actual := testGetNullIfaceValue(nv)
+		if fmt.Sprint(actual.value) != v.expected || actual.valid != v.expectedValid || actual.present != v.present || (err == nil) != v.expectedValid {
+			t.Errorf("json.Unmarshal(%s) to %T failed, %s", v.json, nv, errNull{
+				v.expected, v.expectedValid, nil, actual.value, actual.valid, err,
+			})
+		}
+		if b, _ := json.Marshal(nv); string(b) != v.marshal {
+			t.Errorf("json.Marshal(%T) failed, expected %s instead of %s", nv, v.marshal, b)
+		}
+		nnv := newNotNull()
+		_ = json.Unmarshal([]byte(v.json), nnv)
+		if b, _ := json.Marshal(nnv); string(b) != v.marshalNN {
+			t.Errorf("json.Marshal(%T) failed, expected %s instead of %s", nnv, v.marshalNN, b)
+		}
+	}
+}
+
 func testScanSQL(t *testing.T, nv interface{}) {
 	testData := matrixSuite.GenerateToTyp(matrixSuite.Generate(), reflect.TypeOf(SQLValueType{}))
 	testSuite := func(sv SQLValueType, cnv interface{}, valid bool, err error) {
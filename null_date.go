@@ -0,0 +1,181 @@
+package typ
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+)
+
+// DateCommon represents a Date that may be null.
+type DateCommon struct {
+	P     *Date
+	Error error
+}
+
+// Set saves value into current struct
+func (n *DateCommon) Set(value Date) {
+	n.P = &value
+}
+
+// V returns value of underlying type if it was set, otherwise default value
+func (n DateCommon) V() Date {
+	if n.P == nil {
+		return Date{}
+	}
+	return *n.P
+}
+
+// Present determines whether a value has been set
+func (n DateCommon) Present() bool {
+	return n.P != nil
+}
+
+// Valid determines whether a value has been valid
+func (n DateCommon) Valid() bool {
+	return n.Err() == nil
+}
+
+// Value implements the sql driver Valuer interface.
+// Date is stored as string in format "2006-01-02"
+func (n DateCommon) Value() (driver.Value, error) {
+	return n.V().String(), nil
+}
+
+// Scan implements the sql Scanner interface.
+// Strings & bytes are parsed as dates or timestamps, date of time.Time is taken in its location
+func (n *DateCommon) Scan(value interface{}) error {
+	n.P, n.Error = nil, nil
+	if value == nil {
+		return nil
+	}
+	if v, ok := value.([]byte); ok {
+		value = string(v)
+	}
+	v := Of(value).Date()
+	if v.Err() != nil {
+		n.Error = v.Err()
+		return n.Err()
+	}
+	n.Set(v.V())
+	return nil
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+func (n *DateCommon) UnmarshalJSON(b []byte) error {
+	n.P, n.Error = nil, nil
+	var uv interface{}
+	if err := json.NewDecoder(bytes.NewReader(b)).Decode(&uv); err != nil {
+		n.Error = err
+		return err
+	}
+	if uv == nil {
+		return nil
+	}
+	s, ok := uv.(string)
+	if !ok {
+		n.Error = ErrConvert
+		return n.Err()
+	}
+	v, err := ParseDate(s)
+	if err != nil {
+		n.Error = ErrConvert
+		return n.Err()
+	}
+	n.Set(v)
+	return nil
+}
+
+// MarshalJSON implements the json Marshaler interface.
+func (n DateCommon) MarshalJSON() ([]byte, error) {
+	return json.Marshal(n.V().String())
+}
+
+// Typ returns new instance with himself value.
+// If current value is invalid, nil *Type returned
+func (n DateCommon) Typ(options ...Option) *Type {
+	if n.Err() != nil {
+		return NewType(nil, n.Err())
+	}
+	return NewType(n.V(), n.Err(), options...)
+}
+
+// Err returns underlying error.
+func (n DateCommon) Err() error {
+	return n.Error
+}
+
+// DateAccessor accessor of Date type.
+type DateAccessor interface {
+	Common
+	V() Date
+	Set(value Date)
+	Clone() DateAccessor
+}
+
+// NullDate represents a Date that may be null.
+type NullDate struct {
+	DateCommon
+}
+
+// Value implements the sql driver Valuer interface.
+func (n NullDate) Value() (driver.Value, error) {
+	if n.Err() != nil || !n.Present() {
+		return nil, n.Err()
+	}
+	return n.DateCommon.Value()
+}
+
+// MarshalJSON implements the json Marshaler interface.
+func (n NullDate) MarshalJSON() ([]byte, error) {
+	if n.Err() != nil || !n.Present() {
+		return json.Marshal(nil)
+	}
+	return n.DateCommon.MarshalJSON()
+}
+
+// Clone returns new instance of NullDate with preserved value & error
+func (n NullDate) Clone() DateAccessor {
+	nv := &NullDate{}
+	if n.Present() {
+		nv.Set(n.V())
+	}
+	nv.Error = n.Error
+	return nv
+}
+
+// NDate returns NullDate under DateAccessor from Date
+func NDate(value Date) DateAccessor {
+	return &NullDate{DateCommon{P: &value}}
+}
+
+// NotNullDate represents a Date that may be null.
+type NotNullDate struct {
+	DateCommon
+}
+
+// Clone returns new instance of NotNullDate with preserved value & error
+func (n NotNullDate) Clone() DateAccessor {
+	nv := &NotNullDate{}
+	if n.Present() {
+		nv.Set(n.V())
+	}
+	nv.Error = n.Error
+	return nv
+}
+
+// NNDate returns NotNullDate under DateAccessor from Date
+func NNDate(value Date) DateAccessor {
+	return &NotNullDate{DateCommon{P: &value}}
+}
+
+// DateSlice returns slice of Date with filled values from slice of DateAccessor
+func DateSlice(null []DateAccessor, valid bool) []Date {
+	slice := make([]Date, 0, len(null))
+	for _, v := range null {
+		if valid && v.Err() != nil {
+			continue
+		}
+		slice = append(slice, v.V())
+	}
+	return slice
+}
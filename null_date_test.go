@@ -0,0 +1,128 @@
+package typ
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var (
+	nullDateReflectTypes = []reflect.Type{
+		reflect.TypeOf(&NullDate{}),
+		reflect.TypeOf(&NotNullDate{}),
+	}
+)
+
+func init() {
+	registerNullSuite(nullSuite{
+		types:  nullDateReflectTypes,
+		values: []interface{}{Date{2023, time.November, 14}, Date{1, time.January, 1}},
+		wrap: func(value interface{}, to reflect.Type) interface{} {
+			v := value.(Date)
+			if to == nullDateReflectTypes[0] {
+				return &NullDate{DateCommon{P: &v}}
+			}
+			return &NotNullDate{DateCommon{P: &v}}
+		},
+		token: func(value interface{}) ([]byte, error) {
+			return json.Marshal(value.(Date).String())
+		},
+		sql: func(value interface{}) driver.Value {
+			return value.(Date).String()
+		},
+	})
+}
+
+func TestNullDate(t *testing.T) {
+	for _, nv := range []interface{}{
+		&NullDate{},
+	} {
+		testMarshalJSON(t, nv)
+		testUnmarshalJSON(t, nv)
+		testScanSQL(t, nv)
+		testValueSQL(t, nv)
+		testTyp(t, nv)
+		testClone(t, nv)
+		testSet(t, nv)
+		testNType(t, nv, NDate)
+	}
+}
+
+func TestNotNullDate(t *testing.T) {
+	for _, nv := range []interface{}{
+		&NotNullDate{},
+	} {
+		testMarshalJSON(t, nv)
+		testUnmarshalJSON(t, nv)
+		testScanSQL(t, nv)
+		testValueSQL(t, nv)
+		testTyp(t, nv)
+		testClone(t, nv)
+		testSet(t, nv)
+		testNType(t, nv, NNDate)
+	}
+}
+
+func TestNullDateJSON(t *testing.T) {
+	testNullJSON(t, func() interface{} { return &NullDate{} }, func() interface{} { return &NotNullDate{} }, []nullJSONTest{
+		{`"2023-11-14"`, "2023-11-14", true, true, `"2023-11-14"`, `"2023-11-14"`},
+		{`"0000-00-00"`, "0000-00-00", true, true, `"0000-00-00"`, `"0000-00-00"`},
+		{`null`, "0000-00-00", true, false, `null`, `"0000-00-00"`},
+		{`"2023-11-14T00:00:00Z"`, "0000-00-00", false, false, `null`, `"0000-00-00"`},
+		{`20231114`, "0000-00-00", false, false, `null`, `"0000-00-00"`},
+	})
+}
+
+func TestNullDateScan(t *testing.T) {
+	d := Date{2023, time.November, 14}
+	scanData := []struct {
+		value         interface{}
+		expected      Date
+		expectedValid bool
+		present       bool
+	}{
+		{[]byte("2023-11-14"), d, true, true},
+		{"2023-11-14 00:00:00", d, true, true},
+		{time.Date(2023, 11, 14, 0, 0, 0, 0, time.FixedZone("X", 3600)), d, true, true},
+		{nil, Date{}, true, false},
+		{true, Date{}, false, false},
+	}
+	for _, v := range scanData {
+		nv := &NullDate{}
+		err := nv.Scan(v.value)
+		if nv.V() != v.expected || nv.Valid() != v.expectedValid || nv.Present() != v.present || (err == nil) != v.expectedValid {
+			t.Errorf("NullDate.Scan(%v) failed, %s", v.value, errNull{
+				v.expected, v.expectedValid, nil, nv.V(), nv.Valid(), err,
+			})
+		}
+	}
+	if sv, err := NDate(d).(*NullDate).Value(); sv != "2023-11-14" || err != nil {
+		t.Errorf("NullDate.Value() failed, expected 2023-11-14 instead of %v, error %v", sv, err)
+	}
+	if sv, err := (NullDate{}).Value(); sv != nil || err != nil {
+		t.Errorf("NullDate.Value() failed, expected nil instead of %v, error %v", sv, err)
+	}
+	if sv, err := (NotNullDate{}).Value(); sv != "0000-00-00" || err != nil {
+		t.Errorf("NotNullDate.Value() failed, expected 0000-00-00 instead of %v, error %v", sv, err)
+	}
+	for _, nv := range []DateAccessor{NDate(d), NNDate(d)} {
+		if cv := nv.Clone(); cv.V() != d || reflect.TypeOf(cv) != reflect.TypeOf(nv) {
+			t.Errorf("%T.Clone() failed, expected %v instead of %v", nv, d, cv.V())
+		}
+		if tv := nv.Typ().Time(); !tv.V().Equal(d.In(time.UTC)) {
+			t.Errorf("%T.Typ().Time() failed, expected %v instead of %v", nv, d.In(time.UTC), tv.V())
+		}
+	}
+	if nv := NTime(time.Date(2023, 11, 14, 22, 0, 0, 0, time.UTC)).Typ().Date(); nv.V() != d {
+		t.Errorf("NullTime.Typ().Date() failed, expected %v instead of %v", d, nv.V())
+	}
+	ns := []DateAccessor{NDate(d), NNDate(d), &NullDate{DateCommon{Error: ErrDefaultValue}}}
+	if sl := DateSlice(ns, false); len(sl) != len(ns) {
+		t.Errorf("DateSlice(%v, false), slice length not equal", ns)
+	}
+	if sl := DateSlice(ns, true); len(sl) != len(ns)-1 {
+		t.Errorf("DateSlice(%v, true), slice length not equal", ns)
+	}
+}
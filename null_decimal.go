@@ -0,0 +1,224 @@
+package typ
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+)
+
+// DecimalCommon represents a Decimal that may be null.
+// Scale sets fixed scale of value if it isn't nil, values are padded by zeros on Scan & UnmarshalJSON
+// and values with more fractional digits are rejected, e.g. scale 0 accepts only integral values
+type DecimalCommon struct {
+	P     *Decimal
+	Error error
+	Scale *int
+}
+
+// Set saves value into current struct
+func (n *DecimalCommon) Set(value Decimal) {
+	n.P = &value
+}
+
+// V returns value of underlying type if it was set, otherwise default value
+func (n DecimalCommon) V() Decimal {
+	if n.P == nil {
+		return Decimal{}
+	}
+	return *n.P
+}
+
+// Present determines whether a value has been set
+func (n DecimalCommon) Present() bool {
+	return n.P != nil
+}
+
+// Valid determines whether a value has been valid
+func (n DecimalCommon) Valid() bool {
+	return n.Err() == nil
+}
+
+// Value implements the sql driver Valuer interface.
+// Decimal is stored as string in plain notation, so NUMERIC columns keep exact value
+func (n DecimalCommon) Value() (driver.Value, error) {
+	v, err := n.scaled()
+	if err != nil {
+		return nil, err
+	}
+	return v.String(), nil
+}
+
+// Scan implements the sql Scanner interface.
+func (n *DecimalCommon) Scan(value interface{}) error {
+	n.P, n.Error = nil, nil
+	if value == nil {
+		return nil
+	}
+	if v, ok := value.([]byte); ok {
+		value = string(v)
+	}
+	v := Of(value, n.options()...).Decimal()
+	if v.Err() != nil {
+		n.Error = v.Err()
+		return n.Err()
+	}
+	n.Set(v.V())
+	return nil
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+// JSON numbers and numeric strings are accepted
+func (n *DecimalCommon) UnmarshalJSON(b []byte) error {
+	n.P, n.Error = nil, nil
+	var uv interface{}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&uv); err != nil {
+		n.Error = err
+		return err
+	}
+	if uv == nil {
+		return nil
+	}
+	switch uv.(type) {
+	case json.Number, string:
+	default:
+		n.Error = ErrConvert
+		return n.Err()
+	}
+	v := Of(uv, n.options()...).Decimal()
+	if v.Err() != nil {
+		n.Error = ErrConvert
+		return n.Err()
+	}
+	n.Set(v.V())
+	return nil
+}
+
+// MarshalJSON implements the json Marshaler interface.
+// Decimal is marshaled as JSON number with all fractional digits
+func (n DecimalCommon) MarshalJSON() ([]byte, error) {
+	v, err := n.scaled()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(v.String()), nil
+}
+
+// Returns value rescaled to Scale
+func (n DecimalCommon) scaled() (Decimal, error) {
+	if n.Scale == nil {
+		return n.V(), nil
+	}
+	v, ok := n.V().Rescale(*n.Scale)
+	if !ok {
+		return v, ErrConvert
+	}
+	return v, nil
+}
+
+// Returns options of conversion from SQL & JSON values
+func (n DecimalCommon) options() []Option {
+	if n.Scale == nil {
+		return nil
+	}
+	return []Option{DecimalScale(*n.Scale)}
+}
+
+// Typ returns new instance with himself value.
+// If current value is invalid, nil *Type returned
+func (n DecimalCommon) Typ(options ...Option) *Type {
+	if n.Err() != nil {
+		return NewType(nil, n.Err())
+	}
+	return NewType(n.V(), n.Err(), options...)
+}
+
+// Err returns underlying error.
+func (n DecimalCommon) Err() error {
+	return n.Error
+}
+
+// DecimalAccessor accessor of Decimal type.
+type DecimalAccessor interface {
+	Common
+	V() Decimal
+	Set(value Decimal)
+	Clone() DecimalAccessor
+}
+
+// NullDecimal represents a Decimal that may be null.
+type NullDecimal struct {
+	DecimalCommon
+}
+
+// Value implements the sql driver Valuer interface.
+func (n NullDecimal) Value() (driver.Value, error) {
+	if n.Err() != nil || !n.Present() {
+		return nil, n.Err()
+	}
+	return n.DecimalCommon.Value()
+}
+
+// MarshalJSON implements the json Marshaler interface.
+func (n NullDecimal) MarshalJSON() ([]byte, error) {
+	if n.Err() != nil || !n.Present() {
+		return json.Marshal(nil)
+	}
+	return n.DecimalCommon.MarshalJSON()
+}
+
+// Clone returns new instance of NullDecimal with preserved value & error
+func (n NullDecimal) Clone() DecimalAccessor {
+	nv := &NullDecimal{}
+	if n.Present() {
+		nv.Set(n.V())
+	}
+	nv.Error = n.Error
+	if n.Scale != nil {
+		scale := *n.Scale
+		nv.Scale = &scale
+	}
+	return nv
+}
+
+// NDecimal returns NullDecimal under DecimalAccessor from Decimal
+func NDecimal(value Decimal) DecimalAccessor {
+	return &NullDecimal{DecimalCommon{P: &value}}
+}
+
+// NotNullDecimal represents a Decimal that may be null.
+type NotNullDecimal struct {
+	DecimalCommon
+}
+
+// Clone returns new instance of NotNullDecimal with preserved value & error
+func (n NotNullDecimal) Clone() DecimalAccessor {
+	nv := &NotNullDecimal{}
+	if n.Present() {
+		nv.Set(n.V())
+	}
+	nv.Error = n.Error
+	if n.Scale != nil {
+		scale := *n.Scale
+		nv.Scale = &scale
+	}
+	return nv
+}
+
+// NNDecimal returns NotNullDecimal under DecimalAccessor from Decimal
+func NNDecimal(value Decimal) DecimalAccessor {
+	return &NotNullDecimal{DecimalCommon{P: &value}}
+}
+
+// DecimalSlice returns slice of Decimal with filled values from slice of DecimalAccessor
+func DecimalSlice(null []DecimalAccessor, valid bool) []Decimal {
+	slice := make([]Decimal, 0, len(null))
+	for _, v := range null {
+		if valid && v.Err() != nil {
+			continue
+		}
+		slice = append(slice, v.V())
+	}
+	return slice
+}
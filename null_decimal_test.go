@@ -0,0 +1,156 @@
+package typ
+
+import (
+	"database/sql/driver"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+var (
+	nullDecimalReflectTypes = []reflect.Type{
+		reflect.TypeOf(&NullDecimal{}),
+		reflect.TypeOf(&NotNullDecimal{}),
+	}
+)
+
+func init() {
+	registerNullSuite(nullSuite{
+		types:  nullDecimalReflectTypes,
+		values: []interface{}{NewDecimal(123450, 3), NewDecimal(-5, 2)},
+		wrap: func(value interface{}, to reflect.Type) interface{} {
+			v := value.(Decimal)
+			if to == nullDecimalReflectTypes[0] {
+				return &NullDecimal{DecimalCommon{P: &v}}
+			}
+			return &NotNullDecimal{DecimalCommon{P: &v}}
+		},
+		token: func(value interface{}) ([]byte, error) {
+			return []byte(value.(Decimal).String()), nil
+		},
+		sql: func(value interface{}) driver.Value {
+			return value.(Decimal).String()
+		},
+	})
+	matrixSuite.SetComparator(reflect.TypeOf(Decimal{}), func(a interface{}, b interface{}) bool {
+		return a.(Decimal).String() == b.(Decimal).String()
+	})
+}
+
+func TestNullDecimal(t *testing.T) {
+	for _, nv := range []interface{}{
+		&NullDecimal{},
+	} {
+		testMarshalJSON(t, nv)
+		testUnmarshalJSON(t, nv)
+		testScanSQL(t, nv)
+		testValueSQL(t, nv)
+		testTyp(t, nv)
+		testClone(t, nv)
+		testSet(t, nv)
+		testNType(t, nv, NDecimal)
+	}
+}
+
+func TestNotNullDecimal(t *testing.T) {
+	for _, nv := range []interface{}{
+		&NotNullDecimal{},
+	} {
+		testMarshalJSON(t, nv)
+		testUnmarshalJSON(t, nv)
+		testScanSQL(t, nv)
+		testValueSQL(t, nv)
+		testTyp(t, nv)
+		testClone(t, nv)
+		testSet(t, nv)
+		testNType(t, nv, NNDecimal)
+	}
+}
+
+func TestNullDecimalJSON(t *testing.T) {
+	testNullJSON(t, func() interface{} { return &NullDecimal{} }, func() interface{} { return &NotNullDecimal{} }, []nullJSONTest{
+		{`123.4500`, "123.4500", true, true, `123.4500`, `123.4500`},
+		{`"-0.05"`, "-0.05", true, true, `-0.05`, `-0.05`},
+		{`1e2`, "100", true, true, `100`, `100`},
+		{`null`, "0", true, false, `null`, `0`},
+		{`true`, "0", false, false, `null`, `0`},
+		{`"invalid"`, "0", false, false, `null`, `0`},
+	})
+	zero, two := 0, 2
+	testNullJSON(t, func() interface{} { return &NullDecimal{DecimalCommon{Scale: &two}} }, func() interface{} { return &NotNullDecimal{DecimalCommon{Scale: &two}} }, []nullJSONTest{
+		{`12.5`, "12.50", true, true, `12.50`, `12.50`},
+		{`12.555`, "0", false, false, `null`, `0.00`},
+	})
+	testNullJSON(t, func() interface{} { return &NullDecimal{DecimalCommon{Scale: &zero}} }, func() interface{} { return &NotNullDecimal{DecimalCommon{Scale: &zero}} }, []nullJSONTest{
+		{`12.0`, "12", true, true, `12`, `12`},
+		{`12.5`, "0", false, false, `null`, `0`},
+	})
+}
+
+func TestNullDecimalSQL(t *testing.T) {
+	zero, one, two, four := 0, 1, 2, 4
+	testData := []struct {
+		value         interface{}
+		scale         *int
+		expected      string
+		expectedValid bool
+		present       bool
+		sqlValue      driver.Value
+	}{
+		{[]byte("123.4500"), nil, "123.4500", true, true, "123.4500"},
+		{"99.9", &four, "99.9000", true, true, "99.9000"},
+		{int64(42), &two, "42.00", true, true, "42.00"},
+		{0.25, nil, "0.25", true, true, "0.25"},
+		{"1.005", &two, "0", false, false, nil},
+		{"7.00", &zero, "7", true, true, "7"},
+		{"7.5", &zero, "0", false, false, nil},
+		{nil, nil, "0", true, false, nil},
+		{true, nil, "0", false, false, nil},
+	}
+	for _, v := range testData {
+		nv := &NullDecimal{DecimalCommon{Scale: v.scale}}
+		err := nv.Scan(v.value)
+		if nv.V().String() != v.expected || nv.Valid() != v.expectedValid || nv.Present() != v.present || (err == nil) != v.expectedValid {
+			t.Errorf("NullDecimal.Scan(%v) failed, %s", v.value, errNull{
+				v.expected, v.expectedValid, nil, nv.V(), nv.Valid(), err,
+			})
+		}
+		if sv, _ := nv.Value(); !reflect.DeepEqual(sv, v.sqlValue) {
+			t.Errorf("NullDecimal.Value() failed, expected %v instead of %v", v.sqlValue, sv)
+		}
+	}
+	if sv, err := (NotNullDecimal{DecimalCommon{Scale: &two}}).Value(); sv != "0.00" || err != nil {
+		t.Errorf("NotNullDecimal.Value() failed, expected 0.00 instead of %v, error %v", sv, err)
+	}
+	if _, err := (NullDecimal{DecimalCommon{P: &Decimal{}, Scale: &two}}).Value(); err != nil {
+		t.Errorf("NullDecimal.Value() failed, error %v", err)
+	}
+	nv := NullDecimal{DecimalCommon{Scale: &one}}
+	nv.Set(NewDecimal(125, 2))
+	if _, err := nv.Value(); !errors.Is(err, ErrConvert) {
+		t.Errorf("NullDecimal.Value() failed, expected error %v instead of %v", ErrConvert, err)
+	}
+}
+
+func TestNullDecimalAccessor(t *testing.T) {
+	for _, nv := range []DecimalAccessor{NDecimal(NewDecimal(15, 1)), NNDecimal(NewDecimal(15, 1))} {
+		if nv.V().String() != "1.5" || !nv.Valid() || !nv.Present() {
+			t.Errorf("%T failed, expected 1.5 instead of %v", nv, nv.V())
+		}
+		if c := nv.Clone(); c.V().Cmp(nv.V()) != 0 || reflect.TypeOf(c) != reflect.TypeOf(nv) {
+			t.Errorf("%T.Clone() failed, expected %v instead of %v", nv, nv.V(), c.V())
+		}
+	}
+	three := 3
+	nv := &NullDecimal{DecimalCommon{Scale: &three}}
+	if c := nv.Clone().(*NullDecimal); c.Scale == nil || *c.Scale != 3 || c.Scale == nv.Scale {
+		t.Errorf("NullDecimal.Clone() failed, expected copy of scale 3 instead of %v", c.Scale)
+	}
+	slice := DecimalSlice([]DecimalAccessor{NDecimal(NewDecimal(1, 0)), Of("invalid").Decimal()}, true)
+	if len(slice) != 1 || slice[0].String() != "1" {
+		t.Errorf("DecimalSlice() failed, expected [1] instead of %v", slice)
+	}
+	if nv := NDecimal(NewDecimal(250, 2)).Typ().Float(); nv.V() != 2.5 || nv.Err() != nil {
+		t.Errorf("NDecimal(2.50).Typ().Float() failed, expected 2.5 instead of %v, error %v", nv.V(), nv.Err())
+	}
+}
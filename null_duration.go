@@ -0,0 +1,187 @@
+package typ
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+)
+
+// DurationCommon represents a time.Duration that may be null.
+type DurationCommon struct {
+	P     *time.Duration
+	Error error
+}
+
+// Set saves value into current struct
+func (n *DurationCommon) Set(value time.Duration) {
+	n.P = &value
+}
+
+// V returns value of underlying type if it was set, otherwise default value
+func (n DurationCommon) V() time.Duration {
+	if n.P == nil {
+		return 0
+	}
+	return *n.P
+}
+
+// Present determines whether a value has been set
+func (n DurationCommon) Present() bool {
+	return n.P != nil
+}
+
+// Valid determines whether a value has been valid
+func (n DurationCommon) Valid() bool {
+	return n.Err() == nil
+}
+
+// Value implements the sql driver Valuer interface.
+// Duration is stored as int64 nanoseconds
+func (n DurationCommon) Value() (driver.Value, error) {
+	return int64(n.V()), nil
+}
+
+// Scan implements the sql Scanner interface.
+// Numeric values are nanoseconds, strings are Go durations, ISO 8601 durations or intervals like "1 day 02:30:00"
+func (n *DurationCommon) Scan(value interface{}) error {
+	n.P, n.Error = nil, nil
+	if value == nil {
+		return nil
+	}
+	if v, ok := value.([]byte); ok {
+		value = string(v)
+	}
+	v := Of(value).Duration()
+	if v.Err() != nil {
+		n.Error = v.Err()
+		return n.Err()
+	}
+	n.Set(v.V())
+	return nil
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+// Numbers are nanoseconds, strings are Go durations, ISO 8601 durations or intervals
+func (n *DurationCommon) UnmarshalJSON(b []byte) error {
+	n.P, n.Error = nil, nil
+	var uv interface{}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&uv); err != nil {
+		n.Error = err
+		return err
+	}
+	if uv == nil {
+		return nil
+	}
+	switch uv.(type) {
+	case json.Number, string:
+	default:
+		n.Error = ErrConvert
+		return n.Err()
+	}
+	v := Of(uv).Duration()
+	if v.Err() != nil {
+		n.Error = ErrConvert
+		return n.Err()
+	}
+	n.Set(v.V())
+	return nil
+}
+
+// MarshalJSON implements the json Marshaler interface.
+// Duration is marshaled as Go duration string, e.g. "1m30s"
+func (n DurationCommon) MarshalJSON() ([]byte, error) {
+	return json.Marshal(n.V().String())
+}
+
+// Typ returns new instance with himself value.
+// If current value is invalid, nil *Type returned
+func (n DurationCommon) Typ(options ...Option) *Type {
+	if n.Err() != nil {
+		return NewType(nil, n.Err())
+	}
+	return NewType(n.V(), n.Err(), options...)
+}
+
+// Err returns underlying error.
+func (n DurationCommon) Err() error {
+	return n.Error
+}
+
+// DurationAccessor accessor of time.Duration type.
+type DurationAccessor interface {
+	Common
+	V() time.Duration
+	Set(value time.Duration)
+	Clone() DurationAccessor
+}
+
+// NullDuration represents a time.Duration that may be null.
+type NullDuration struct {
+	DurationCommon
+}
+
+// Value implements the sql driver Valuer interface.
+func (n NullDuration) Value() (driver.Value, error) {
+	if n.Err() != nil || !n.Present() {
+		return nil, n.Err()
+	}
+	return n.DurationCommon.Value()
+}
+
+// MarshalJSON implements the json Marshaler interface.
+func (n NullDuration) MarshalJSON() ([]byte, error) {
+	if n.Err() != nil || !n.Present() {
+		return json.Marshal(nil)
+	}
+	return n.DurationCommon.MarshalJSON()
+}
+
+// Clone returns new instance of NullDuration with preserved value & error
+func (n NullDuration) Clone() DurationAccessor {
+	nv := &NullDuration{}
+	if n.Present() {
+		nv.Set(n.V())
+	}
+	nv.Error = n.Error
+	return nv
+}
+
+// NDuration returns NullDuration under DurationAccessor from time.Duration
+func NDuration(value time.Duration) DurationAccessor {
+	return &NullDuration{DurationCommon{P: &value}}
+}
+
+// NotNullDuration represents a time.Duration that may be null.
+type NotNullDuration struct {
+	DurationCommon
+}
+
+// Clone returns new instance of NotNullDuration with preserved value & error
+func (n NotNullDuration) Clone() DurationAccessor {
+	nv := &NotNullDuration{}
+	if n.Present() {
+		nv.Set(n.V())
+	}
+	nv.Error = n.Error
+	return nv
+}
+
+// NNDuration returns NotNullDuration under DurationAccessor from time.Duration
+func NNDuration(value time.Duration) DurationAccessor {
+	return &NotNullDuration{DurationCommon{P: &value}}
+}
+
+// DurationSlice returns slice of time.Duration with filled values from slice of DurationAccessor
+func DurationSlice(null []DurationAccessor, valid bool) []time.Duration {
+	slice := make([]time.Duration, 0, len(null))
+	for _, v := range null {
+		if valid && v.Err() != nil {
+			continue
+		}
+		slice = append(slice, v.V())
+	}
+	return slice
+}
@@ -0,0 +1,140 @@
+package typ
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var (
+	nullDurationReflectTypes = []reflect.Type{
+		reflect.TypeOf(&NullDuration{}),
+		reflect.TypeOf(&NotNullDuration{}),
+	}
+)
+
+func init() {
+	registerNullSuite(nullSuite{
+		types:  nullDurationReflectTypes,
+		values: []interface{}{90 * time.Second, -time.Nanosecond},
+		wrap: func(value interface{}, to reflect.Type) interface{} {
+			v := value.(time.Duration)
+			if to == nullDurationReflectTypes[0] {
+				return &NullDuration{DurationCommon{P: &v}}
+			}
+			return &NotNullDuration{DurationCommon{P: &v}}
+		},
+		token: func(value interface{}) ([]byte, error) {
+			return json.Marshal(value.(time.Duration).String())
+		},
+		sql: func(value interface{}) driver.Value {
+			return int64(value.(time.Duration))
+		},
+	})
+}
+
+func TestNullDuration(t *testing.T) {
+	for _, nv := range []interface{}{
+		&NullDuration{},
+	} {
+		testMarshalJSON(t, nv)
+		testUnmarshalJSON(t, nv)
+		testScanSQL(t, nv)
+		testValueSQL(t, nv)
+		testTyp(t, nv)
+		testClone(t, nv)
+		testSet(t, nv)
+		testNType(t, nv, NDuration)
+	}
+}
+
+func TestNotNullDuration(t *testing.T) {
+	for _, nv := range []interface{}{
+		&NotNullDuration{},
+	} {
+		testMarshalJSON(t, nv)
+		testUnmarshalJSON(t, nv)
+		testScanSQL(t, nv)
+		testValueSQL(t, nv)
+		testTyp(t, nv)
+		testClone(t, nv)
+		testSet(t, nv)
+		testNType(t, nv, NNDuration)
+	}
+}
+
+func TestNullDurationJSON(t *testing.T) {
+	testNullJSON(t, func() interface{} { return &NullDuration{} }, func() interface{} { return &NotNullDuration{} }, []nullJSONTest{
+		{`"1m30s"`, "1m30s", true, true, `"1m30s"`, `"1m30s"`},
+		{`"PT1H"`, "1h0m0s", true, true, `"1h0m0s"`, `"1h0m0s"`},
+		{`1500000000`, "1.5s", true, true, `"1.5s"`, `"1.5s"`},
+		{`null`, "0s", true, false, `null`, `"0s"`},
+		{`true`, "0s", false, false, `null`, `"0s"`},
+		{`"invalid"`, "0s", false, false, `null`, `"0s"`},
+	})
+}
+
+func TestNullDurationSQL(t *testing.T) {
+	testData := []struct {
+		value         interface{}
+		expected      time.Duration
+		expectedValid bool
+		present       bool
+		sqlValue      driver.Value
+	}{
+		{int64(90e9), 90 * time.Second, true, true, int64(90e9)},
+		{[]byte("01:30:00"), 90 * time.Minute, true, true, int64(90 * time.Minute)},
+		{"1 day 00:00:01", 24*time.Hour + time.Second, true, true, int64(24*time.Hour + time.Second)},
+		{float64(1.5e9), 1500 * time.Millisecond, true, true, int64(1.5e9)},
+		{nil, 0, true, false, nil},
+		{time.Now(), 0, false, false, nil},
+	}
+	for _, v := range testData {
+		nv := &NullDuration{}
+		err := nv.Scan(v.value)
+		if nv.V() != v.expected || nv.Valid() != v.expectedValid || nv.Present() != v.present || (err == nil) != v.expectedValid {
+			t.Errorf("NullDuration.Scan(%v) failed, %s", v.value, errNull{
+				v.expected, v.expectedValid, nil, nv.V(), nv.Valid(), err,
+			})
+		}
+		if sv, _ := nv.Value(); !reflect.DeepEqual(sv, v.sqlValue) {
+			t.Errorf("NullDuration.Value() failed, expected %v instead of %v", v.sqlValue, sv)
+		}
+	}
+	if sv, err := (NotNullDuration{}).Value(); sv != int64(0) || err != nil {
+		t.Errorf("NotNullDuration.Value() failed, expected 0 instead of %v, error %v", sv, err)
+	}
+}
+
+func TestNullDurationAccessor(t *testing.T) {
+	for _, nv := range []DurationAccessor{NDuration(time.Second), NNDuration(time.Second)} {
+		cv := nv.Clone()
+		if cv.V() != time.Second || reflect.TypeOf(cv) != reflect.TypeOf(nv) {
+			t.Errorf("%T.Clone() failed, expected %v instead of %v", nv, time.Second, cv.V())
+		}
+		cv.Set(time.Minute)
+		if nv.V() != time.Second || cv.V() != time.Minute {
+			t.Errorf("%T.Set() failed, clone isn't independent", nv)
+		}
+		if tv := nv.Typ(DurationUnit(time.Hour)).Duration(); tv.V() != time.Second {
+			t.Errorf("%T.Typ() failed, expected %v instead of %v", nv, time.Second, tv.V())
+		}
+	}
+	if tv := (&NullDuration{DurationCommon{Error: ErrConvert}}).Typ().Duration(); !errors.Is(tv.Err(), ErrConvert) {
+		t.Errorf("NullDuration.Typ() failed, expected error %v instead of %v", ErrConvert, tv.Err())
+	}
+	ns := []DurationAccessor{
+		NDuration(time.Second),
+		NNDuration(time.Minute),
+		&NullDuration{DurationCommon{Error: ErrDefaultValue}},
+	}
+	if sl := DurationSlice(ns, false); len(sl) != len(ns) {
+		t.Errorf("DurationSlice(%v, false), slice length not equal", ns)
+	}
+	if sl := DurationSlice(ns, true); len(sl) != len(ns)-1 {
+		t.Errorf("DurationSlice(%v, true), slice length not equal", ns)
+	}
+}
@@ -0,0 +1,191 @@
+package typ
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// Null represents a value of any primitive type that may be null.
+type Null[T Primitive] struct {
+	P     *T
+	Error error
+	Lost  bool
+}
+
+// Set saves value into current struct
+func (n *Null[T]) Set(value T) {
+	n.P = &value
+}
+
+// V returns value of underlying type if it was set, otherwise default value
+func (n Null[T]) V() T {
+	if n.P == nil {
+		var v T
+		return v
+	}
+	return *n.P
+}
+
+// Present determines whether a value has been set
+func (n Null[T]) Present() bool {
+	return n.P != nil
+}
+
+// Valid determines whether a value has been valid
+func (n Null[T]) Valid() bool {
+	return n.Err() == nil
+}
+
+// Value implements the sql driver Valuer interface.
+func (n Null[T]) Value() (driver.Value, error) {
+	if n.Err() != nil || !n.Present() {
+		return nil, n.Err()
+	}
+	kind := primitiveType[T]().Kind()
+	switch {
+	case isInt(kind), isUint(kind):
+		v := To[int64](n.V())
+		return v.V(), v.Err()
+	case isFloat(kind), isComplex(kind):
+		v := To[float64](n.V())
+		return v.V(), v.Err()
+	case isBool(kind):
+		return To[bool](n.V()).V(), nil
+	}
+	return To[string](n.V()).V(), nil
+}
+
+// Scan implements the sql Scanner interface.
+func (n *Null[T]) Scan(value interface{}) error {
+	n.P, n.Error = nil, nil
+	if value == nil {
+		return nil
+	}
+	if v, ok := value.([]byte); ok {
+		value = string(v)
+	}
+	v := To[T](value)
+	if v.Err() != nil {
+		n.Error = v.Err()
+		return v.Err()
+	}
+	n.Set(v.V())
+	return nil
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+func (n *Null[T]) UnmarshalJSON(b []byte) error {
+	n.P, n.Error = nil, nil
+	var uv interface{}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&uv); err != nil {
+		n.Error = err
+		return err
+	}
+	if uv == nil {
+		return nil
+	}
+	kind := primitiveType[T]().Kind()
+	switch v := uv.(type) {
+	case json.Number:
+		if !isNumeric(kind) || isComplex(kind) {
+			n.Error = ErrConvert
+			return n.Err()
+		}
+		number, err := jsonNumberValue(v)
+		if err != nil {
+			n.Error = ErrConvert
+			return n.Err()
+		}
+		uv = number
+	case string:
+		if !isString(kind) && !isComplex(kind) {
+			n.Error = ErrConvert
+			return n.Err()
+		}
+	case bool:
+		if !isBool(kind) {
+			n.Error = ErrConvert
+			return n.Err()
+		}
+	default:
+		n.Error = ErrConvert
+		return n.Err()
+	}
+	v := To[T](uv)
+	if v.Err() != nil {
+		n.Error = ErrConvert
+		return n.Err()
+	}
+	n.Set(v.V())
+	return nil
+}
+
+// MarshalJSON implements the json Marshaler interface.
+func (n Null[T]) MarshalJSON() ([]byte, error) {
+	if n.Err() != nil || !n.Present() {
+		return json.Marshal(nil)
+	}
+	if isComplex(primitiveType[T]().Kind()) {
+		return json.Marshal(fmt.Sprintf("%v", n.V()))
+	}
+	return json.Marshal(n.V())
+}
+
+// Typ returns new instance with himself value.
+// If current value is invalid, nil *Type returned
+func (n Null[T]) Typ(options ...Option) *Type {
+	if n.Err() != nil {
+		return NewType(nil, n.Err())
+	}
+	return NewType(n.V(), n.Err(), options...)
+}
+
+// Err returns underlying error.
+func (n Null[T]) Err() error {
+	return n.Error
+}
+
+// PrecisionLost determines whether a value was changed by rounding or overflow policy
+func (n Null[T]) PrecisionLost() bool {
+	return n.Lost
+}
+
+// Clone returns new instance of Null with preserved value & error
+func (n Null[T]) Clone() Accessor[T] {
+	nv := &Null[T]{}
+	if n.Present() {
+		nv.Set(n.V())
+	}
+	nv.Error, nv.Lost = n.Error, n.Lost
+	return nv
+}
+
+// Accessor accessor of any primitive type.
+type Accessor[T Primitive] interface {
+	Common
+	V() T
+	Set(value T)
+	PrecisionLost() bool
+	Clone() Accessor[T]
+}
+
+// N returns Null under Accessor from value of any primitive type
+func N[T Primitive](value T) Accessor[T] {
+	return &Null[T]{P: &value}
+}
+
+// Slice returns slice of primitive type with filled values from slice of Accessor
+func Slice[T Primitive](null []Accessor[T], valid bool) []T {
+	slice := make([]T, 0, len(null))
+	for _, v := range null {
+		if valid && v.Err() != nil {
+			continue
+		}
+		slice = append(slice, v.V())
+	}
+	return slice
+}
@@ -0,0 +1,119 @@
+package typ
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestNullGenericJSON(t *testing.T) {
+	testData := []struct {
+		nv       interface{}
+		token    string
+		expected interface{}
+		err      error
+	}{
+		{&Null[int]{}, `42`, 42, nil},
+		{&Null[int]{}, `null`, 0, nil},
+		{&Null[int]{}, `42.5`, 42, ErrConvert},
+		{&Null[int]{}, `"42"`, 0, ErrConvert},
+		{&Null[int8]{}, `300`, int8(0), ErrConvert},
+		{&Null[int64]{}, `9223372036854775807`, MaxInt64, nil},
+		{&Null[uint64]{}, `18446744073709551615`, MaxUint64, nil},
+		{&Null[uint64]{}, `-1`, uint64(0), ErrConvert},
+		{&Null[float64]{}, `3.5`, 3.5, nil},
+		{&Null[float32]{}, `3.1415926535`, float32(0), ErrConvert},
+		{&Null[complex128]{}, `"(1+2i)"`, complex(1, 2), nil},
+		{&Null[complex128]{}, `1`, complex128(0), ErrConvert},
+		{&Null[bool]{}, `true`, true, nil},
+		{&Null[bool]{}, `1`, false, ErrConvert},
+		{&Null[string]{}, `"str"`, "str", nil},
+		{&Null[string]{}, `1`, "", ErrConvert},
+	}
+	for _, v := range testData {
+		err := v.nv.(json.Unmarshaler).UnmarshalJSON([]byte(v.token))
+		actual := v.nv.(interface{ Typ(...Option) *Type }).Typ().Interface().V()
+		if err != v.err || (err == nil && v.token != "null" && actual != v.expected) {
+			t.Errorf("%T{}.UnmarshalJSON([]byte(%s)), %s", v.nv, v.token, errNull{
+				v.expected, v.err == nil, v.err,
+				actual, err == nil, err,
+			})
+			continue
+		}
+		if err != nil || v.token == "null" {
+			continue
+		}
+		b, err := json.Marshal(v.nv)
+		if err != nil || string(b) != v.token {
+			t.Errorf("%T{%v}.MarshalJSON() failed, expected (expected == actual) []byte (%s == %s), error %v", v.nv, actual, v.token, b, err)
+		}
+	}
+	b, err := json.Marshal(Null[int]{Error: ErrConvert})
+	if err != nil || string(b) != "null" {
+		t.Errorf("Null[int]{Error: ErrConvert}.MarshalJSON() failed, expected (expected == actual) []byte (null == %s), error %v", b, err)
+	}
+}
+
+func TestNullGenericSQL(t *testing.T) {
+	testData := []struct {
+		nv       driver.Valuer
+		expected driver.Value
+		err      error
+	}{
+		{To[int8](42), int64(42), nil},
+		{To[uint16](42), int64(42), nil},
+		{To[uint64](MaxUint64), int64(-1), ErrConvert},
+		{To[float32](3.5), 3.5, nil},
+		{To[complex128](complex(3.5, 0)), 3.5, nil},
+		{To[complex128](complex(3.5, 1)), 3.5, ErrConvert},
+		{To[bool](true), true, nil},
+		{To[string]("str"), "str", nil},
+		{&Null[int]{}, nil, nil},
+		{&Null[int]{Error: ErrConvert}, nil, ErrConvert},
+	}
+	for _, v := range testData {
+		actual, err := v.nv.Value()
+		if actual != v.expected || !errors.Is(err, v.err) {
+			t.Errorf("%T{%+[1]v}.Value() failed, expected (expected == actual) %v == %v, error %v", v.nv, v.expected, actual, err)
+		}
+	}
+	nv := &Null[uint8]{}
+	if err := nv.Scan([]byte("42")); err != nil || nv.V() != 42 {
+		t.Errorf("Null[uint8]{}.Scan([]byte(42)) failed, expected value by reference %s", errNull{uint8(42), true, nil, nv.V(), nv.Valid(), err})
+	}
+	if err := nv.Scan(int64(256)); !errors.Is(err, ErrConvert) || nv.Present() {
+		t.Errorf("Null[uint8]{}.Scan(256) failed, expected value by reference %s", errNull{nil, false, ErrConvert, nv.V(), nv.Valid(), err})
+	}
+	if err := nv.Scan(nil); err != nil || nv.Present() {
+		t.Errorf("Null[uint8]{}.Scan(nil) failed, expected value by reference %s", errNull{nil, true, nil, nv.V(), nv.Valid(), err})
+	}
+}
+
+func TestNullGenericClone(t *testing.T) {
+	nv := N(42)
+	cv := nv.Clone()
+	cv.Set(43)
+	if nv.V() != 42 || cv.V() != 43 {
+		t.Errorf("N(42).Clone() failed, expected independent copy (expected == actual) %v == %v", 42, nv.V())
+	}
+	if ev := (Null[int]{Error: ErrConvert}).Clone(); ev.Present() || ev.Err() != ErrConvert {
+		t.Errorf("Null[int]{Error: ErrConvert}.Clone() failed, %s", errNull{nil, false, ErrConvert, ev.V(), ev.Valid(), ev.Err()})
+	}
+}
+
+func TestNullGenericSlice(t *testing.T) {
+	ns := []Accessor[int]{
+		N(0),
+		N(1),
+		&Null[int]{Error: ErrDefaultValue},
+	}
+	sl := Slice(ns, false)
+	if len(sl) != len(ns) || cap(sl) != cap(ns) {
+		t.Errorf("Slice(%v, false), slice length not equal", ns)
+	}
+	sl = Slice(ns, true)
+	if len(sl) != len(ns)-1 || cap(sl) != cap(ns) {
+		t.Errorf("Slice(%v, true), slice length not equal", ns)
+	}
+}
@@ -9,6 +9,7 @@ import (
 type IntCommon struct {
 	P     *int
 	Error error
+	Lost  bool
 }
 
 // Set saves value into current struct
@@ -58,20 +59,15 @@ func (n *IntCommon) Scan(value interface{}) error {
 // UnmarshalJSON implements the json Unmarshaler interface.
 func (n *IntCommon) UnmarshalJSON(b []byte) error {
 	n.P, n.Error = nil, nil
-	var uv interface{}
-	if err := json.Unmarshal(b, &uv); err != nil {
+	uv, err := decodeJSONNumber(b, false)
+	if err != nil {
 		n.Error = err
 		return err
 	}
 	if uv == nil {
 		return nil
 	}
-	vFloat, ok := uv.(float64)
-	if !ok {
-		n.Error = ErrConvert
-		return n.Err()
-	}
-	v := FloatInt(vFloat)
+	v := Of(uv).Int()
 	if v.Err() != nil {
 		n.Error = ErrConvert
 		return n.Err()
@@ -82,11 +78,7 @@ func (n *IntCommon) UnmarshalJSON(b []byte) error {
 
 // MarshalJSON implements the json Marshaler interface.
 func (n IntCommon) MarshalJSON() ([]byte, error) {
-	v := Of(n.V()).Float()
-	if v.Err() != nil {
-		return nil, ErrConvert
-	}
-	return json.Marshal(v.V())
+	return json.Marshal(n.V())
 }
 
 // Typ returns new instance with himself value.
@@ -103,11 +95,17 @@ func (n IntCommon) Err() error {
 	return n.Error
 }
 
+// PrecisionLost determines whether a value was changed by rounding or overflow policy
+func (n IntCommon) PrecisionLost() bool {
+	return n.Lost
+}
+
 // IntAccessor accessor of int type.
 type IntAccessor interface {
 	Common
 	V() int
 	Set(value int)
+	PrecisionLost() bool
 	Clone() IntAccessor
 }
 
@@ -138,7 +136,7 @@ func (n NullInt) Clone() IntAccessor {
 	if n.Present() {
 		nv.Set(n.V())
 	}
-	nv.Error = n.Error
+	nv.Error, nv.Lost = n.Error, n.Lost
 	return nv
 }
 
@@ -158,7 +156,7 @@ func (n NotNullInt) Clone() IntAccessor {
 	if n.Present() {
 		nv.Set(n.V())
 	}
-	nv.Error = n.Error
+	nv.Error, nv.Lost = n.Error, n.Lost
 	return nv
 }
 
@@ -183,6 +181,7 @@ func IntSlice(null []IntAccessor, valid bool) []int {
 type Int8Common struct {
 	P     *int8
 	Error error
+	Lost  bool
 }
 
 // Set saves value into current struct
@@ -232,20 +231,15 @@ func (n *Int8Common) Scan(value interface{}) error {
 // UnmarshalJSON implements the json Unmarshaler interface.
 func (n *Int8Common) UnmarshalJSON(b []byte) error {
 	n.P, n.Error = nil, nil
-	var uv interface{}
-	if err := json.Unmarshal(b, &uv); err != nil {
+	uv, err := decodeJSONNumber(b, false)
+	if err != nil {
 		n.Error = err
 		return err
 	}
 	if uv == nil {
 		return nil
 	}
-	vFloat, ok := uv.(float64)
-	if !ok {
-		n.Error = ErrConvert
-		return n.Err()
-	}
-	v := FloatInt8(vFloat)
+	v := Of(uv).Int8()
 	if v.Err() != nil {
 		n.Error = ErrConvert
 		return n.Err()
@@ -256,8 +250,7 @@ func (n *Int8Common) UnmarshalJSON(b []byte) error {
 
 // MarshalJSON implements the json Marshaler interface.
 func (n Int8Common) MarshalJSON() ([]byte, error) {
-	v := Of(n.V()).Float()
-	return json.Marshal(v.V())
+	return json.Marshal(n.V())
 }
 
 // Typ returns new instance with himself value.
@@ -274,11 +267,17 @@ func (n Int8Common) Err() error {
 	return n.Error
 }
 
+// PrecisionLost determines whether a value was changed by rounding or overflow policy
+func (n Int8Common) PrecisionLost() bool {
+	return n.Lost
+}
+
 // Int8Accessor accessor of int8 type.
 type Int8Accessor interface {
 	Common
 	V() int8
 	Set(value int8)
+	PrecisionLost() bool
 	Clone() Int8Accessor
 }
 
@@ -309,7 +308,7 @@ func (n NullInt8) Clone() Int8Accessor {
 	if n.Present() {
 		nv.Set(n.V())
 	}
-	nv.Error = n.Error
+	nv.Error, nv.Lost = n.Error, n.Lost
 	return nv
 }
 
@@ -329,7 +328,7 @@ func (n NotNullInt8) Clone() Int8Accessor {
 	if n.Present() {
 		nv.Set(n.V())
 	}
-	nv.Error = n.Error
+	nv.Error, nv.Lost = n.Error, n.Lost
 	return nv
 }
 
@@ -354,6 +353,7 @@ func Int8Slice(null []Int8Accessor, valid bool) []int8 {
 type Int16Common struct {
 	P     *int16
 	Error error
+	Lost  bool
 }
 
 // Set saves value into current struct
@@ -403,20 +403,15 @@ func (n *Int16Common) Scan(value interface{}) error {
 // UnmarshalJSON implements the json Unmarshaler interface.
 func (n *Int16Common) UnmarshalJSON(b []byte) error {
 	n.P, n.Error = nil, nil
-	var uv interface{}
-	if err := json.Unmarshal(b, &uv); err != nil {
+	uv, err := decodeJSONNumber(b, false)
+	if err != nil {
 		n.Error = err
 		return err
 	}
 	if uv == nil {
 		return nil
 	}
-	vFloat, ok := uv.(float64)
-	if !ok {
-		n.Error = ErrConvert
-		return n.Err()
-	}
-	v := FloatInt16(vFloat)
+	v := Of(uv).Int16()
 	if v.Err() != nil {
 		n.Error = ErrConvert
 		return n.Err()
@@ -427,8 +422,7 @@ func (n *Int16Common) UnmarshalJSON(b []byte) error {
 
 // MarshalJSON implements the json Marshaler interface.
 func (n Int16Common) MarshalJSON() ([]byte, error) {
-	v := Of(n.V()).Float()
-	return json.Marshal(v.V())
+	return json.Marshal(n.V())
 }
 
 // Typ returns new instance with himself value.
@@ -445,11 +439,17 @@ func (n Int16Common) Err() error {
 	return n.Error
 }
 
+// PrecisionLost determines whether a value was changed by rounding or overflow policy
+func (n Int16Common) PrecisionLost() bool {
+	return n.Lost
+}
+
 // Int16Accessor accessor of int16 type.
 type Int16Accessor interface {
 	Common
 	V() int16
 	Set(value int16)
+	PrecisionLost() bool
 	Clone() Int16Accessor
 }
 
@@ -480,7 +480,7 @@ func (n NullInt16) Clone() Int16Accessor {
 	if n.Present() {
 		nv.Set(n.V())
 	}
-	nv.Error = n.Error
+	nv.Error, nv.Lost = n.Error, n.Lost
 	return nv
 }
 
@@ -500,7 +500,7 @@ func (n NotNullInt16) Clone() Int16Accessor {
 	if n.Present() {
 		nv.Set(n.V())
 	}
-	nv.Error = n.Error
+	nv.Error, nv.Lost = n.Error, n.Lost
 	return nv
 }
 
@@ -525,6 +525,7 @@ func Int16Slice(null []Int16Accessor, valid bool) []int16 {
 type Int32Common struct {
 	P     *int32
 	Error error
+	Lost  bool
 }
 
 // Set saves value into current struct
@@ -574,20 +575,15 @@ func (n *Int32Common) Scan(value interface{}) error {
 // UnmarshalJSON implements the json Unmarshaler interface.
 func (n *Int32Common) UnmarshalJSON(b []byte) error {
 	n.P, n.Error = nil, nil
-	var uv interface{}
-	if err := json.Unmarshal(b, &uv); err != nil {
+	uv, err := decodeJSONNumber(b, false)
+	if err != nil {
 		n.Error = err
 		return err
 	}
 	if uv == nil {
 		return nil
 	}
-	vFloat, ok := uv.(float64)
-	if !ok {
-		n.Error = ErrConvert
-		return n.Err()
-	}
-	v := FloatInt32(vFloat)
+	v := Of(uv).Int32()
 	if v.Err() != nil {
 		n.Error = ErrConvert
 		return n.Err()
@@ -598,8 +594,7 @@ func (n *Int32Common) UnmarshalJSON(b []byte) error {
 
 // MarshalJSON implements the json Marshaler interface.
 func (n Int32Common) MarshalJSON() ([]byte, error) {
-	v := Of(n.V()).Float()
-	return json.Marshal(v.V())
+	return json.Marshal(n.V())
 }
 
 // Typ returns new instance with himself value.
@@ -616,11 +611,17 @@ func (n Int32Common) Err() error {
 	return n.Error
 }
 
+// PrecisionLost determines whether a value was changed by rounding or overflow policy
+func (n Int32Common) PrecisionLost() bool {
+	return n.Lost
+}
+
 // Int32Accessor accessor of int32 type.
 type Int32Accessor interface {
 	Common
 	V() int32
 	Set(value int32)
+	PrecisionLost() bool
 	Clone() Int32Accessor
 }
 
@@ -651,7 +652,7 @@ func (n NullInt32) Clone() Int32Accessor {
 	if n.Present() {
 		nv.Set(n.V())
 	}
-	nv.Error = n.Error
+	nv.Error, nv.Lost = n.Error, n.Lost
 	return nv
 }
 
@@ -671,7 +672,7 @@ func (n NotNullInt32) Clone() Int32Accessor {
 	if n.Present() {
 		nv.Set(n.V())
 	}
-	nv.Error = n.Error
+	nv.Error, nv.Lost = n.Error, n.Lost
 	return nv
 }
 
@@ -696,6 +697,7 @@ func Int32Slice(null []Int32Accessor, valid bool) []int32 {
 type Int64Common struct {
 	P     *int64
 	Error error
+	Lost  bool
 }
 
 // Set saves value into current struct
@@ -745,20 +747,15 @@ func (n *Int64Common) Scan(value interface{}) error {
 // UnmarshalJSON implements the json Unmarshaler interface.
 func (n *Int64Common) UnmarshalJSON(b []byte) error {
 	n.P, n.Error = nil, nil
-	var uv interface{}
-	if err := json.Unmarshal(b, &uv); err != nil {
+	uv, err := decodeJSONNumber(b, false)
+	if err != nil {
 		n.Error = err
 		return err
 	}
 	if uv == nil {
 		return nil
 	}
-	vFloat, ok := uv.(float64)
-	if !ok {
-		n.Error = ErrConvert
-		return n.Err()
-	}
-	v := FloatInt64(vFloat)
+	v := Of(uv).Int64()
 	if v.Err() != nil {
 		n.Error = ErrConvert
 		return n.Err()
@@ -769,11 +766,7 @@ func (n *Int64Common) UnmarshalJSON(b []byte) error {
 
 // MarshalJSON implements the json Marshaler interface.
 func (n Int64Common) MarshalJSON() ([]byte, error) {
-	v := Of(n.V()).Float()
-	if v.Err() != nil {
-		return nil, ErrConvert
-	}
-	return json.Marshal(v.V())
+	return json.Marshal(n.V())
 }
 
 // Typ returns new instance with himself value.
@@ -790,11 +783,17 @@ func (n Int64Common) Err() error {
 	return n.Error
 }
 
+// PrecisionLost determines whether a value was changed by rounding or overflow policy
+func (n Int64Common) PrecisionLost() bool {
+	return n.Lost
+}
+
 // Int64Accessor accessor of int64 type.
 type Int64Accessor interface {
 	Common
 	V() int64
 	Set(value int64)
+	PrecisionLost() bool
 	Clone() Int64Accessor
 }
 
@@ -825,7 +824,7 @@ func (n NullInt64) Clone() Int64Accessor {
 	if n.Present() {
 		nv.Set(n.V())
 	}
-	nv.Error = n.Error
+	nv.Error, nv.Lost = n.Error, n.Lost
 	return nv
 }
 
@@ -845,7 +844,7 @@ func (n NotNullInt64) Clone() Int64Accessor {
 	if n.Present() {
 		nv.Set(n.V())
 	}
-	nv.Error = n.Error
+	nv.Error, nv.Lost = n.Error, n.Lost
 	return nv
 }
 
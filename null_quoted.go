@@ -0,0 +1,59 @@
+package typ
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// Quoted represents an integer that may be null, it is encoded in JSON as a quoted string.
+// Use it for consumers which decode JSON numbers as float64 (e.g. JavaScript) and lose precision beyond 2^53.
+// Both quoted and plain JSON numbers are accepted by UnmarshalJSON
+type Quoted[T Integer] struct {
+	Null[T]
+}
+
+// UnmarshalJSON implements the json Unmarshaler interface.
+func (n *Quoted[T]) UnmarshalJSON(b []byte) error {
+	n.P, n.Error = nil, nil
+	uv, err := decodeJSONNumber(b, true)
+	if err != nil {
+		n.Error = err
+		return err
+	}
+	if uv == nil {
+		return nil
+	}
+	v := To[T](uv)
+	if v.Err() != nil {
+		n.Error = ErrConvert
+		return n.Err()
+	}
+	n.Set(v.V())
+	return nil
+}
+
+// MarshalJSON implements the json Marshaler interface.
+func (n Quoted[T]) MarshalJSON() ([]byte, error) {
+	if n.Err() != nil || !n.Present() {
+		return json.Marshal(nil)
+	}
+	if isSignedType[T]() {
+		return json.Marshal(strconv.FormatInt(int64(n.V()), 10))
+	}
+	return json.Marshal(strconv.FormatUint(uint64(n.V()), 10))
+}
+
+// Clone returns new instance of Quoted with preserved value & error
+func (n Quoted[T]) Clone() Accessor[T] {
+	nv := &Quoted[T]{}
+	if n.Present() {
+		nv.Set(n.V())
+	}
+	nv.Error, nv.Lost = n.Error, n.Lost
+	return nv
+}
+
+// Q returns Quoted under Accessor from value of any integer type
+func Q[T Integer](value T) Accessor[T] {
+	return &Quoted[T]{Null[T]{P: &value}}
+}
@@ -0,0 +1,63 @@
+package typ
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNullIntegerJSONExact(t *testing.T) {
+	const snowflake = 1234567890123456789
+	in, un := NullInt64{}, NullUint64{}
+	if err := json.Unmarshal([]byte("1234567890123456789"), &in); err != nil || in.V() != snowflake {
+		t.Errorf("NullInt64{}.UnmarshalJSON(%d) failed, expected value by reference %s", snowflake, errNull{int64(snowflake), true, nil, in.V(), in.Valid(), err})
+	}
+	if b, err := json.Marshal(in); err != nil || string(b) != "1234567890123456789" {
+		t.Errorf("NullInt64{%d}.MarshalJSON() failed, unexpected result %s, error %v", snowflake, b, err)
+	}
+	if err := json.Unmarshal([]byte("18446744073709551615"), &un); err != nil || un.V() != MaxUint64 {
+		t.Errorf("NullUint64{}.UnmarshalJSON(MaxUint64) failed, expected value by reference %s", errNull{uint64(MaxUint64), true, nil, un.V(), un.Valid(), err})
+	}
+	if b, err := json.Marshal(un); err != nil || string(b) != "18446744073709551615" {
+		t.Errorf("NullUint64{MaxUint64}.MarshalJSON() failed, unexpected result %s, error %v", b, err)
+	}
+	if err := json.Unmarshal([]byte("1e3"), &in); err != nil || in.V() != 1000 {
+		t.Errorf("NullInt64{}.UnmarshalJSON(1e3) failed, expected value by reference %s", errNull{int64(1000), true, nil, in.V(), in.Valid(), err})
+	}
+	for _, v := range []string{`"42"`, `1.5`, `true`, `9223372036854775808`} {
+		if err := json.Unmarshal([]byte(v), &in); err != ErrConvert || in.Present() {
+			t.Errorf("NullInt64{}.UnmarshalJSON(%s) failed, expected value by reference %s", v, errNull{nil, false, ErrConvert, in.V(), in.Valid(), err})
+		}
+	}
+}
+
+func TestQuoted(t *testing.T) {
+	var data struct {
+		ID     Quoted[int64]  `json:"id"`
+		Parent Quoted[uint64] `json:"parent"`
+		Next   Quoted[int8]   `json:"next"`
+	}
+	if err := json.Unmarshal([]byte(`{"id":"1234567890123456789","parent":18446744073709551615,"next":null}`), &data); err != nil {
+		t.Fatalf("json.Unmarshal failed, unexpected error %v", err)
+	}
+	if data.ID.V() != 1234567890123456789 || data.Parent.V() != MaxUint64 || data.Next.Present() {
+		t.Errorf("json.Unmarshal failed, unexpected result %v, %v, %v", data.ID.V(), data.Parent.V(), data.Next.P)
+	}
+	b, err := json.Marshal(data)
+	if expected := `{"id":"1234567890123456789","parent":"18446744073709551615","next":null}`; err != nil || string(b) != expected {
+		t.Errorf("json.Marshal failed, expected %s instead of %s, error %v", expected, b, err)
+	}
+	if b, err := json.Marshal(Q(int8(-42))); err != nil || string(b) != `"-42"` {
+		t.Errorf("Q(-42).MarshalJSON() failed, unexpected result %s, error %v", b, err)
+	}
+	for _, v := range []string{`"300"`, `"abc"`, `"1.5"`, `true`} {
+		if err := json.Unmarshal([]byte(v), &data.Next); err != ErrConvert || data.Next.Present() {
+			t.Errorf("Quoted[int8]{}.UnmarshalJSON(%s) failed, expected value by reference %s", v, errNull{nil, false, ErrConvert, data.Next.V(), data.Next.Valid(), err})
+		}
+	}
+	if nv := data.ID.Clone(); nv.V() != data.ID.V() {
+		t.Errorf("Quoted[int64].Clone() failed, expected %v instead of %v", data.ID.V(), nv.V())
+	}
+	if _, ok := data.ID.Clone().(*Quoted[int64]); !ok {
+		t.Error("Quoted[int64].Clone() failed, expected *Quoted[int64]")
+	}
+}
@@ -1,15 +1,39 @@
 package typ
 
 import (
+	"bytes"
 	"database/sql/driver"
 	"encoding/json"
+	"strconv"
 	"time"
 )
 
+// TimeFormat is a format of time.Time in JSON, either layout or one of Unix formats
+type TimeFormat string
+
+const (
+	// TimeFormatRFC3339 formats time as RFC 3339 string with nanoseconds
+	TimeFormatRFC3339 TimeFormat = time.RFC3339Nano
+	// TimeFormatDate formats time as date string, e.g. "2006-01-02"
+	TimeFormatDate TimeFormat = "2006-01-02"
+	// TimeFormatUnix formats time as number of seconds since Unix epoch
+	TimeFormatUnix TimeFormat = "unix"
+	// TimeFormatUnixMilli formats time as number of milliseconds since Unix epoch
+	TimeFormatUnixMilli TimeFormat = "unixmilli"
+)
+
+// DefaultTimeFormat is a JSON format of time values which doesn't set own Format
+var DefaultTimeFormat = TimeFormatRFC3339
+
 // TimeCommon represents a time.Time that may be null.
+// Format sets JSON format of value, DefaultTimeFormat is used if it is empty.
+// Layouts sets name of layouts registered by RegisterTimeLayouts which are accepted for strings from SQL & JSON,
+// DefaultTimeLayouts is used if it is empty
 type TimeCommon struct {
-	P     *time.Time
-	Error error
+	P       *time.Time
+	Error   error
+	Format  TimeFormat
+	Layouts string
 }
 
 // Set saves value into current struct
@@ -41,27 +65,93 @@ func (n TimeCommon) Value() (driver.Value, error) {
 }
 
 // Scan implements the sql Scanner interface.
+// Strings & bytes are parsed by Layouts, numbers are counted from Unix epoch by Format or detected by magnitude
 func (n *TimeCommon) Scan(value interface{}) error {
-	n.Error = nil
-	var tv time.Time
-	tv, ok := value.(time.Time)
-	if !ok {
-		n.Error = ErrInvalidArgument
+	n.P, n.Error = nil, nil
+	if value == nil {
+		return nil
+	}
+	if v, ok := value.([]byte); ok {
+		value = string(v)
+	}
+	v := Of(value, n.options()...).Time()
+	if v.Err() != nil {
+		n.Error = v.Err()
+		return n.Err()
 	}
-	n.P = &tv
-	return n.Err()
+	n.Set(v.V())
+	return nil
 }
 
 // UnmarshalJSON implements the json Unmarshaler interface.
+// Strings are parsed by Format & Layouts, numbers are counted from Unix epoch by Format or detected by magnitude
 func (n *TimeCommon) UnmarshalJSON(b []byte) error {
-	v := n.V()
-	n.Error = v.UnmarshalJSON(b)
-	return n.Err()
+	n.P, n.Error = nil, nil
+	var uv interface{}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&uv); err != nil {
+		n.Error = err
+		return err
+	}
+	if uv == nil {
+		return nil
+	}
+	switch uv.(type) {
+	case json.Number, string:
+	default:
+		n.Error = ErrConvert
+		return n.Err()
+	}
+	v := Of(uv, n.options()...).Time()
+	if v.Err() != nil {
+		n.Error = ErrConvert
+		return n.Err()
+	}
+	n.Set(v.V())
+	return nil
 }
 
 // MarshalJSON implements the json Marshaler interface.
 func (n TimeCommon) MarshalJSON() ([]byte, error) {
-	return n.V().MarshalJSON()
+	switch format := n.format(); format {
+	case TimeFormatUnix:
+		return []byte(strconv.FormatInt(n.V().Unix(), 10)), nil
+	case TimeFormatUnixMilli:
+		return []byte(strconv.FormatInt(n.V().UnixMilli(), 10)), nil
+	case TimeFormatRFC3339:
+		return n.V().MarshalJSON()
+	default:
+		return json.Marshal(n.V().Format(string(format)))
+	}
+}
+
+// Returns JSON format of value
+func (n TimeCommon) format() TimeFormat {
+	if n.Format == "" {
+		return DefaultTimeFormat
+	}
+	return n.Format
+}
+
+// Returns options of conversion from SQL & JSON values
+func (n TimeCommon) options() []Option {
+	layouts := DefaultTimeLayouts
+	if n.Layouts != "" {
+		var ok bool
+		// Unregistered layouts fail conversion by empty TimeLayouts option
+		if layouts, ok = lookupTimeLayouts(n.Layouts); !ok {
+			return []Option{TimeLayouts()}
+		}
+	}
+	switch format := n.format(); format {
+	case TimeFormatUnix:
+		return []Option{TimeLayouts(layouts...), Epoch(EpochSeconds)}
+	case TimeFormatUnixMilli:
+		return []Option{TimeLayouts(layouts...), Epoch(EpochMilliseconds)}
+	default:
+		return []Option{TimeLayouts(append([]string{string(format)}, layouts...)...), Epoch(EpochAuto)}
+	}
 }
 
 // Typ returns new instance with himself value.
@@ -113,7 +203,7 @@ func (n NullTime) Clone() TimeAccessor {
 	if n.Present() {
 		nv.Set(n.V())
 	}
-	nv.Error = n.Error
+	nv.Error, nv.Format, nv.Layouts = n.Error, n.Format, n.Layouts
 	return nv
 }
 
@@ -133,7 +223,7 @@ func (n NotNullTime) Clone() TimeAccessor {
 	if n.Present() {
 		nv.Set(n.V())
 	}
-	nv.Error = n.Error
+	nv.Error, nv.Format, nv.Layouts = n.Error, n.Format, n.Layouts
 	return nv
 }
 
@@ -139,3 +139,93 @@ func TestNullTimeSlice(t *testing.T) {
 		t.Errorf("NullTimeSlice(%v, true), slice length not equal", ns)
 	}
 }
+
+func TestNullTimeFormat(t *testing.T) {
+	base := time.Date(2023, 11, 14, 22, 13, 20, 0, time.UTC)
+	testData := []struct {
+		format   TimeFormat
+		json     string
+		expected time.Time
+		marshal  string
+	}{
+		{"", `"2023-11-14T22:13:20Z"`, base, `"2023-11-14T22:13:20Z"`},
+		{"", `1700000000`, base, `"2023-11-14T22:13:20Z"`},
+		{"", `1700000000000`, base, `"2023-11-14T22:13:20Z"`},
+		{TimeFormatDate, `"2023-11-14"`, base.Truncate(24 * time.Hour), `"2023-11-14"`},
+		{TimeFormatUnix, `1700000000`, base, `1700000000`},
+		{TimeFormatUnix, `"2023-11-14 22:13:20"`, base, `1700000000`},
+		{TimeFormatUnixMilli, `1700000000000`, base, `1700000000000`},
+		{"02.01.2006 15:04:05", `"14.11.2023 22:13:20"`, base, `"14.11.2023 22:13:20"`},
+	}
+	for _, v := range testData {
+		nv := &NullTime{TimeCommon{Format: v.format}}
+		err := json.Unmarshal([]byte(v.json), nv)
+		if !nv.V().Equal(v.expected) || err != nil {
+			t.Errorf("json.Unmarshal(%s) to NullTime{Format: %q} failed, %s", v.json, v.format, errNull{
+				v.expected, true, nil, nv.V(), nv.Valid(), err,
+			})
+		}
+		if b, err := json.Marshal(nv); string(b) != v.marshal || err != nil {
+			t.Errorf("json.Marshal(NullTime{Format: %q}) failed, expected %s instead of %s, error %v", v.format, v.marshal, b, err)
+		}
+	}
+	for _, data := range []string{`true`, `"invalid"`, `{}`} {
+		nv := &NullTime{}
+		if err := json.Unmarshal([]byte(data), nv); err == nil || nv.Present() {
+			t.Errorf("json.Unmarshal(%s) to NullTime must returns error", data)
+		}
+	}
+	nv := &NullTime{}
+	if err := json.Unmarshal([]byte(`null`), nv); err != nil || nv.Present() {
+		t.Errorf("json.Unmarshal(null) to NullTime failed, expected not present value, error %v", err)
+	}
+	DefaultTimeFormat = TimeFormatUnix
+	defer func() { DefaultTimeFormat = TimeFormatRFC3339 }()
+	if b, _ := json.Marshal(NTime(base)); string(b) != `1700000000` {
+		t.Errorf("json.Marshal(NullTime) with DefaultTimeFormat failed, expected 1700000000 instead of %s", b)
+	}
+	if cv := (&NotNullTime{TimeCommon{P: &base, Format: TimeFormatDate}}).Clone(); cv.(*NotNullTime).Format != TimeFormatDate {
+		t.Errorf("NotNullTime.Clone() failed, format isn't preserved")
+	}
+}
+
+func TestNullTimeScan(t *testing.T) {
+	base := time.Date(2023, 11, 14, 22, 13, 20, 0, time.UTC)
+	testData := []struct {
+		value         interface{}
+		layouts       string
+		expected      time.Time
+		expectedValid bool
+		present       bool
+	}{
+		{base, "", base, true, true},
+		{"2023-11-14 22:13:20", "", base, true, true},
+		{[]byte("2023-11-14T22:13:20Z"), "", base, true, true},
+		{int64(1700000000), "", base, true, true},
+		{int64(1700000000000), "", base, true, true},
+		{float64(1700000000.5), "", base.Add(500 * time.Millisecond), true, true},
+		{"14/11/2023 22:13:20", "dmy", base, true, true},
+		{"14/11/2023 22:13:20", "", time.Time{}, false, false},
+		{"14/11/2023 22:13:20", "unknown", time.Time{}, false, false},
+		{true, "", time.Time{}, false, false},
+		{nil, "", time.Time{}, true, false},
+	}
+	if err := RegisterTimeLayouts("dmy", "02/01/2006 15:04:05"); err != nil {
+		t.Fatalf("RegisterTimeLayouts(dmy) failed, error %v", err)
+	}
+	if err := RegisterTimeLayouts("empty"); err != ErrInvalidArgument {
+		t.Errorf("RegisterTimeLayouts(empty) failed, expected error %v instead of %v", ErrInvalidArgument, err)
+	}
+	if (NullTime{TimeCommon{Layouts: "dmy"}}) != (NullTime{TimeCommon{Layouts: "dmy"}}) {
+		t.Error("NullTime must be comparable")
+	}
+	for _, v := range testData {
+		nv := &NullTime{TimeCommon{Layouts: v.layouts}}
+		err := nv.Scan(v.value)
+		if !nv.V().Equal(v.expected) || nv.Valid() != v.expectedValid || nv.Present() != v.present || (err == nil) != v.expectedValid {
+			t.Errorf("NullTime.Scan(%v) failed, %s", v.value, errNull{
+				v.expected, v.expectedValid, nil, nv.V(), nv.Valid(), err,
+			})
+		}
+	}
+}
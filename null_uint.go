@@ -9,6 +9,7 @@ import (
 type UintCommon struct {
 	P     *uint
 	Error error
+	Lost  bool
 }
 
 // Set saves value into current struct
@@ -59,20 +60,15 @@ func (n *UintCommon) Scan(value interface{}) error {
 // UnmarshalJSON implements the json Unmarshaler interface.
 func (n *UintCommon) UnmarshalJSON(b []byte) error {
 	n.P, n.Error = nil, nil
-	var uv interface{}
-	if err := json.Unmarshal(b, &uv); err != nil {
+	uv, err := decodeJSONNumber(b, false)
+	if err != nil {
 		n.Error = err
 		return err
 	}
 	if uv == nil {
 		return nil
 	}
-	vFloat, ok := uv.(float64)
-	if !ok {
-		n.Error = ErrConvert
-		return n.Err()
-	}
-	v := FloatUint(vFloat)
+	v := Of(uv).Uint()
 	if v.Err() != nil {
 		n.Error = ErrConvert
 		return n.Err()
@@ -83,11 +79,7 @@ func (n *UintCommon) UnmarshalJSON(b []byte) error {
 
 // MarshalJSON implements the json Marshaler interface.
 func (n UintCommon) MarshalJSON() ([]byte, error) {
-	v := Of(n.V()).Float()
-	if v.Err() != nil {
-		return nil, ErrConvert
-	}
-	return json.Marshal(v.V())
+	return json.Marshal(n.V())
 }
 
 // Typ returns new instance with himself value.
@@ -104,11 +96,17 @@ func (n UintCommon) Err() error {
 	return n.Error
 }
 
+// PrecisionLost determines whether a value was changed by rounding or overflow policy
+func (n UintCommon) PrecisionLost() bool {
+	return n.Lost
+}
+
 // UintAccessor accessor of uint type.
 type UintAccessor interface {
 	Common
 	V() uint
 	Set(value uint)
+	PrecisionLost() bool
 	Clone() UintAccessor
 }
 
@@ -139,7 +137,7 @@ func (n NullUint) Clone() UintAccessor {
 	if n.Present() {
 		nv.Set(n.V())
 	}
-	nv.Error = n.Error
+	nv.Error, nv.Lost = n.Error, n.Lost
 	return nv
 }
 
@@ -159,7 +157,7 @@ func (n NotNullUint) Clone() UintAccessor {
 	if n.Present() {
 		nv.Set(n.V())
 	}
-	nv.Error = n.Error
+	nv.Error, nv.Lost = n.Error, n.Lost
 	return nv
 }
 
@@ -184,6 +182,7 @@ func UintSlice(null []UintAccessor, valid bool) []uint {
 type Uint8Common struct {
 	P     *uint8
 	Error error
+	Lost  bool
 }
 
 // Set saves value into current struct
@@ -233,20 +232,15 @@ func (n *Uint8Common) Scan(value interface{}) error {
 // UnmarshalJSON implements the json Unmarshaler interface.
 func (n *Uint8Common) UnmarshalJSON(b []byte) error {
 	n.P, n.Error = nil, nil
-	var uv interface{}
-	if err := json.Unmarshal(b, &uv); err != nil {
+	uv, err := decodeJSONNumber(b, false)
+	if err != nil {
 		n.Error = err
 		return err
 	}
 	if uv == nil {
 		return nil
 	}
-	vFloat, ok := uv.(float64)
-	if !ok {
-		n.Error = ErrConvert
-		return n.Err()
-	}
-	v := FloatUint8(vFloat)
+	v := Of(uv).Uint8()
 	if v.Err() != nil {
 		n.Error = ErrConvert
 		return n.Err()
@@ -257,8 +251,7 @@ func (n *Uint8Common) UnmarshalJSON(b []byte) error {
 
 // MarshalJSON implements the json Marshaler interface.
 func (n Uint8Common) MarshalJSON() ([]byte, error) {
-	v := Of(n.V()).Float()
-	return json.Marshal(v.V())
+	return json.Marshal(n.V())
 }
 
 // Typ returns new instance with himself value.
@@ -275,11 +268,17 @@ func (n Uint8Common) Err() error {
 	return n.Error
 }
 
+// PrecisionLost determines whether a value was changed by rounding or overflow policy
+func (n Uint8Common) PrecisionLost() bool {
+	return n.Lost
+}
+
 // Uint8Accessor accessor of uint8 type.
 type Uint8Accessor interface {
 	Common
 	V() uint8
 	Set(value uint8)
+	PrecisionLost() bool
 	Clone() Uint8Accessor
 }
 
@@ -310,7 +309,7 @@ func (n NullUint8) Clone() Uint8Accessor {
 	if n.Present() {
 		nv.Set(n.V())
 	}
-	nv.Error = n.Error
+	nv.Error, nv.Lost = n.Error, n.Lost
 	return nv
 }
 
@@ -330,7 +329,7 @@ func (n NotNullUint8) Clone() Uint8Accessor {
 	if n.Present() {
 		nv.Set(n.V())
 	}
-	nv.Error = n.Error
+	nv.Error, nv.Lost = n.Error, n.Lost
 	return nv
 }
 
@@ -355,6 +354,7 @@ func Uint8Slice(null []Uint8Accessor, valid bool) []uint8 {
 type Uint16Common struct {
 	P     *uint16
 	Error error
+	Lost  bool
 }
 
 // Set saves value into current struct
@@ -404,20 +404,15 @@ func (n *Uint16Common) Scan(value interface{}) error {
 // UnmarshalJSON implements the json Unmarshaler interface.
 func (n *Uint16Common) UnmarshalJSON(b []byte) error {
 	n.P, n.Error = nil, nil
-	var uv interface{}
-	if err := json.Unmarshal(b, &uv); err != nil {
+	uv, err := decodeJSONNumber(b, false)
+	if err != nil {
 		n.Error = err
 		return err
 	}
 	if uv == nil {
 		return nil
 	}
-	vFloat, ok := uv.(float64)
-	if !ok {
-		n.Error = ErrConvert
-		return n.Err()
-	}
-	v := FloatUint16(vFloat)
+	v := Of(uv).Uint16()
 	if v.Err() != nil {
 		n.Error = ErrConvert
 		return n.Err()
@@ -428,8 +423,7 @@ func (n *Uint16Common) UnmarshalJSON(b []byte) error {
 
 // MarshalJSON implements the json Marshaler interface.
 func (n Uint16Common) MarshalJSON() ([]byte, error) {
-	v := Of(n.V()).Float()
-	return json.Marshal(v.V())
+	return json.Marshal(n.V())
 }
 
 // Typ returns new instance with himself value.
@@ -446,11 +440,17 @@ func (n Uint16Common) Err() error {
 	return n.Error
 }
 
+// PrecisionLost determines whether a value was changed by rounding or overflow policy
+func (n Uint16Common) PrecisionLost() bool {
+	return n.Lost
+}
+
 // Uint16Accessor accessor of uint16 type.
 type Uint16Accessor interface {
 	Common
 	V() uint16
 	Set(value uint16)
+	PrecisionLost() bool
 	Clone() Uint16Accessor
 }
 
@@ -481,7 +481,7 @@ func (n NullUint16) Clone() Uint16Accessor {
 	if n.Present() {
 		nv.Set(n.V())
 	}
-	nv.Error = n.Error
+	nv.Error, nv.Lost = n.Error, n.Lost
 	return nv
 }
 
@@ -501,7 +501,7 @@ func (n NotNullUint16) Clone() Uint16Accessor {
 	if n.Present() {
 		nv.Set(n.V())
 	}
-	nv.Error = n.Error
+	nv.Error, nv.Lost = n.Error, n.Lost
 	return nv
 }
 
@@ -526,6 +526,7 @@ func Uint16Slice(null []Uint16Accessor, valid bool) []uint16 {
 type Uint32Common struct {
 	P     *uint32
 	Error error
+	Lost  bool
 }
 
 // Set saves value into current struct
@@ -575,20 +576,15 @@ func (n *Uint32Common) Scan(value interface{}) error {
 // UnmarshalJSON implements the json Unmarshaler interface.
 func (n *Uint32Common) UnmarshalJSON(b []byte) error {
 	n.P, n.Error = nil, nil
-	var uv interface{}
-	if err := json.Unmarshal(b, &uv); err != nil {
+	uv, err := decodeJSONNumber(b, false)
+	if err != nil {
 		n.Error = err
 		return err
 	}
 	if uv == nil {
 		return nil
 	}
-	vFloat, ok := uv.(float64)
-	if !ok {
-		n.Error = ErrConvert
-		return n.Err()
-	}
-	v := FloatUint32(vFloat)
+	v := Of(uv).Uint32()
 	if v.Err() != nil {
 		n.Error = ErrConvert
 		return n.Err()
@@ -599,8 +595,7 @@ func (n *Uint32Common) UnmarshalJSON(b []byte) error {
 
 // MarshalJSON implements the json Marshaler interface.
 func (n Uint32Common) MarshalJSON() ([]byte, error) {
-	v := Of(n.V()).Float()
-	return json.Marshal(v.V())
+	return json.Marshal(n.V())
 }
 
 // Typ returns new instance with himself value.
@@ -617,11 +612,17 @@ func (n Uint32Common) Err() error {
 	return n.Error
 }
 
+// PrecisionLost determines whether a value was changed by rounding or overflow policy
+func (n Uint32Common) PrecisionLost() bool {
+	return n.Lost
+}
+
 // Uint32Accessor accessor of uint32 type.
 type Uint32Accessor interface {
 	Common
 	V() uint32
 	Set(value uint32)
+	PrecisionLost() bool
 	Clone() Uint32Accessor
 }
 
@@ -652,7 +653,7 @@ func (n NullUint32) Clone() Uint32Accessor {
 	if n.Present() {
 		nv.Set(n.V())
 	}
-	nv.Error = n.Error
+	nv.Error, nv.Lost = n.Error, n.Lost
 	return nv
 }
 
@@ -672,7 +673,7 @@ func (n NotNullUint32) Clone() Uint32Accessor {
 	if n.Present() {
 		nv.Set(n.V())
 	}
-	nv.Error = n.Error
+	nv.Error, nv.Lost = n.Error, n.Lost
 	return nv
 }
 
@@ -697,6 +698,7 @@ func Uint32Slice(null []Uint32Accessor, valid bool) []uint32 {
 type Uint64Common struct {
 	P     *uint64
 	Error error
+	Lost  bool
 }
 
 // Set saves value into current struct
@@ -747,20 +749,15 @@ func (n *Uint64Common) Scan(value interface{}) error {
 // UnmarshalJSON implements the json Unmarshaler interface.
 func (n *Uint64Common) UnmarshalJSON(b []byte) error {
 	n.P, n.Error = nil, nil
-	var uv interface{}
-	if err := json.Unmarshal(b, &uv); err != nil {
+	uv, err := decodeJSONNumber(b, false)
+	if err != nil {
 		n.Error = err
 		return err
 	}
 	if uv == nil {
 		return nil
 	}
-	vFloat, ok := uv.(float64)
-	if !ok {
-		n.Error = ErrConvert
-		return n.Err()
-	}
-	v := FloatUint64(vFloat)
+	v := Of(uv).Uint64()
 	if v.Err() != nil {
 		n.Error = ErrConvert
 		return n.Err()
@@ -771,11 +768,7 @@ func (n *Uint64Common) UnmarshalJSON(b []byte) error {
 
 // MarshalJSON implements the json Marshaler interface.
 func (n Uint64Common) MarshalJSON() ([]byte, error) {
-	v := Of(n.V()).Float()
-	if v.Err() != nil {
-		return nil, ErrConvert
-	}
-	return json.Marshal(v.V())
+	return json.Marshal(n.V())
 }
 
 // Typ returns new instance with himself value.
@@ -792,11 +785,17 @@ func (n Uint64Common) Err() error {
 	return n.Error
 }
 
+// PrecisionLost determines whether a value was changed by rounding or overflow policy
+func (n Uint64Common) PrecisionLost() bool {
+	return n.Lost
+}
+
 // Uint64Accessor accessor of uint64 type.
 type Uint64Accessor interface {
 	Common
 	V() uint64
 	Set(value uint64)
+	PrecisionLost() bool
 	Clone() Uint64Accessor
 }
 
@@ -827,7 +826,7 @@ func (n NullUint64) Clone() Uint64Accessor {
 	if n.Present() {
 		nv.Set(n.V())
 	}
-	nv.Error = n.Error
+	nv.Error, nv.Lost = n.Error, n.Lost
 	return nv
 }
 
@@ -847,7 +846,7 @@ func (n NotNullUint64) Clone() Uint64Accessor {
 	if n.Present() {
 		nv.Set(n.V())
 	}
-	nv.Error = n.Error
+	nv.Error, nv.Lost = n.Error, n.Lost
 	return nv
 }
 
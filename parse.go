@@ -0,0 +1,152 @@
+package typ
+
+import (
+	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// TrimSpace trims leading and trailing white space of strings before conversion to numeric types
+func TrimSpace() Option {
+	return func(t *opts) error {
+		t.trimSpace = true
+		return nil
+	}
+}
+
+// DigitSeparator set separator of digit groups for conversion of strings to numeric types, e.g. ',' for "1,234,567".
+// Groups of integer part of decimal numbers must have 3 digits, numbers with base prefix may have separator between any digits.
+// The separator can't be a letter, digit, sign or decimal point
+func DigitSeparator(sep rune) Option {
+	return func(t *opts) error {
+		if sep == '.' || !validLocaleRune(sep) {
+			return ErrInvalidArgument
+		}
+		t.separator = sep
+		return nil
+	}
+}
+
+// IntegralFloats allow conversion of strings in decimal or exponent form like "3.0" or "1e3" to integer types,
+// the conversion is rejected if value has fractional part
+func IntegralFloats() Option {
+	return func(t *opts) error {
+		t.integral = true
+		return nil
+	}
+}
+
+// Returns string value prepared for conversion to numeric types by parse options.
+// Returns false if separators are misplaced
+func (t *Type) numericString() (string, bool) {
+	s := t.rv.String()
+	if t.opts.trimSpace {
+		s = strings.TrimSpace(s)
+	}
+	switch {
+	case t.opts.locale != nil:
+		return t.opts.locale.parse(s, t.opts.separator)
+	case t.opts.separator != 0:
+		return removeSeparator(s, t.opts.separator, 3, '.')
+	}
+	return s, true
+}
+
+// Parse string value to int64 by parse options, value must fit to bitSize
+func (t *Type) parseInt(bitSize int) (int64, error) {
+	s, ok := t.numericString()
+	if !ok {
+		return 0, &strconv.NumError{Func: "ParseInt", Num: s, Err: strconv.ErrSyntax}
+	}
+	v, err := strconv.ParseInt(s, 0, bitSize)
+	if err != nil && t.opts.integral && errors.Is(err, strconv.ErrSyntax) {
+		if i, ok := integralString(s); ok {
+			if !i.IsInt64() || !isSafeInt(i.Int64(), bitSize) {
+				return 0, &strconv.NumError{Func: "ParseInt", Num: s, Err: strconv.ErrRange}
+			}
+			return i.Int64(), nil
+		}
+	}
+	return v, err
+}
+
+// Parse string value to uint64 by parse options, value must fit to bitSize
+func (t *Type) parseUint(bitSize int) (uint64, error) {
+	s, ok := t.numericString()
+	if !ok {
+		return 0, &strconv.NumError{Func: "ParseUint", Num: s, Err: strconv.ErrSyntax}
+	}
+	v, err := strconv.ParseUint(s, 0, bitSize)
+	if err != nil && t.opts.integral && errors.Is(err, strconv.ErrSyntax) {
+		if i, ok := integralString(s); ok && i.Sign() >= 0 {
+			if !i.IsUint64() || !isSafeUint(i.Uint64(), bitSize) {
+				return 0, &strconv.NumError{Func: "ParseUint", Num: s, Err: strconv.ErrRange}
+			}
+			return i.Uint64(), nil
+		}
+	}
+	return v, err
+}
+
+// Parse string value to float64 by parse options, value must fit to bitSize
+func (t *Type) parseFloat(bitSize int) (float64, error) {
+	s, ok := t.numericString()
+	if !ok {
+		return 0, &strconv.NumError{Func: "ParseFloat", Num: s, Err: strconv.ErrSyntax}
+	}
+	return strconv.ParseFloat(s, bitSize)
+}
+
+// Returns integer value of decimal string like "3.0" or "1.5e3", false is returned if value isn't integral
+func integralString(s string) (*big.Int, bool) {
+	d, err := ParseDecimal(s)
+	if err != nil || !d.IsInt() {
+		return nil, false
+	}
+	return d.Rat().Num(), true
+}
+
+// Remove digit group separator from numeric string & replace decimal point by '.'.
+// Groups of integer part must have size digits, numbers with base prefix may have separator between any digits.
+// Returns false if separator is misplaced
+func removeSeparator(s string, sep rune, size int, point rune) (string, bool) {
+	sign, body := "", s
+	if body != "" && (body[0] == '-' || body[0] == '+') {
+		sign, body = body[:1], body[1:]
+	}
+	if len(body) > 2 && body[0] == '0' && strings.ContainsRune("xXoObB", rune(body[1])) {
+		if sep == 0 || !strings.ContainsRune(body, sep) {
+			return s, true
+		}
+		for _, group := range strings.Split(body[2:], string(sep)) {
+			if group == "" {
+				return s, false
+			}
+		}
+		return sign + strings.ReplaceAll(body, string(sep), ""), true
+	}
+	intPart, rest := body, ""
+	if i := strings.IndexFunc(body, func(r rune) bool { return r == point || r == 'e' || r == 'E' }); i >= 0 {
+		intPart, rest = body[:i], body[i:]
+	}
+	if sep != 0 && strings.ContainsRune(rest, sep) {
+		return s, false
+	}
+	if point != '.' {
+		if strings.ContainsRune(intPart, '.') && sep != '.' {
+			return s, false
+		}
+		rest = strings.Replace(rest, string(point), ".", 1)
+	}
+	if sep == 0 || !strings.ContainsRune(intPart, sep) {
+		return sign + intPart + rest, true
+	}
+	groups := strings.Split(intPart, string(sep))
+	for i, group := range groups {
+		if !isDigits(group) || len(group) > size || group == "" || (i > 0 && len(group) != size) {
+			return s, false
+		}
+	}
+	return sign + strings.Join(groups, "") + rest, true
+}
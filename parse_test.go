@@ -0,0 +1,95 @@
+package typ
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseOptions(t *testing.T) {
+	testData := []struct {
+		value    string
+		options  []Option
+		expected int64
+		err      error
+	}{
+		{"0x1F", nil, 31, nil},
+		{"0o17", nil, 15, nil},
+		{"0b101", nil, 5, nil},
+		{"1_000", nil, 1000, nil},
+		{" 42 ", nil, 0, ErrConvert},
+		{" 42 ", []Option{TrimSpace()}, 42, nil},
+		{"\t-42\n", []Option{TrimSpace()}, -42, nil},
+		{"1,234,567", nil, 0, ErrConvert},
+		{"1,234,567", []Option{DigitSeparator(',')}, 1234567, nil},
+		{"-1 234", []Option{DigitSeparator(' ')}, -1234, nil},
+		{" 1 234 ", []Option{TrimSpace(), DigitSeparator(' ')}, 1234, nil},
+		{"1'000", []Option{DigitSeparator('\'')}, 1000, nil},
+		{"0xFF,FF", []Option{DigitSeparator(',')}, 65535, nil},
+		{"0xFF,,FF", []Option{DigitSeparator(',')}, 0, ErrConvert},
+		{"1,5", []Option{DigitSeparator(',')}, 0, ErrConvert},
+		{"12,34,567", []Option{DigitSeparator(',')}, 0, ErrConvert},
+		{",123", []Option{DigitSeparator(',')}, 0, ErrConvert},
+		{"123,", []Option{DigitSeparator(',')}, 0, ErrConvert},
+		{"3.0", nil, 0, ErrConvert},
+		{"3.0", []Option{IntegralFloats()}, 3, nil},
+		{"1e3", []Option{IntegralFloats()}, 1000, nil},
+		{"1.5E2", []Option{IntegralFloats()}, 150, nil},
+		{"-2.50e1", []Option{IntegralFloats()}, -25, nil},
+		{"3.5", []Option{IntegralFloats()}, 0, ErrConvert},
+		{"1e19", []Option{IntegralFloats()}, 0, ErrConvert},
+		{"1e99999", []Option{IntegralFloats()}, 0, ErrConvert},
+		{"1,234.000", []Option{DigitSeparator(','), IntegralFloats()}, 1234, nil},
+		{"1,234.0,00", []Option{DigitSeparator(','), IntegralFloats()}, 0, ErrConvert},
+		{"1", []Option{DigitSeparator('.')}, 0, ErrInvalidArgument},
+		{"1", []Option{DigitSeparator('a')}, 0, ErrInvalidArgument},
+		{"1", []Option{DigitSeparator(0)}, 0, ErrInvalidArgument},
+	}
+	for _, v := range testData {
+		nv := Of(v.value, v.options...).Int64()
+		if nv.V() != v.expected || !errors.Is(nv.Err(), v.err) {
+			t.Errorf("Of(%q).Int64() failed, expected (expected == actual) %v == %v, error %v == %v",
+				v.value, v.expected, nv.V(), v.err, nv.Err(),
+			)
+		}
+	}
+	var ce *ConversionError
+	if nv := Of("1e19", IntegralFloats()).Int64(); !errors.As(nv.Err(), &ce) || ce.Reason != ReasonOverflow {
+		t.Errorf("Of(1e19).Int64() failed, expected overflow reason instead of %v", nv.Err())
+	}
+	if nv := Of("300.0", IntegralFloats()).Int8(); !errors.As(nv.Err(), &ce) || ce.Reason != ReasonOverflow {
+		t.Errorf("Of(300.0).Int8() failed, expected overflow reason instead of %v", nv.Err())
+	}
+}
+
+func TestParseOptionsUintFloat(t *testing.T) {
+	if nv := Of(" 4,294,967,295 ", TrimSpace(), DigitSeparator(',')).Uint32(); nv.V() != MaxUint32 || nv.Err() != nil {
+		t.Errorf("Of().Uint32() failed, expected %v instead of %v, error %v", MaxUint32, nv.V(), nv.Err())
+	}
+	if nv := Of("2.56e2", IntegralFloats()).Uint8(); nv.Err() == nil {
+		t.Errorf("Of(2.56e2).Uint8() failed, expected error instead of %v", nv.V())
+	}
+	if nv := Of("-1.0", IntegralFloats()).Uint(); nv.Err() == nil {
+		t.Errorf("Of(-1.0).Uint() failed, expected error instead of %v", nv.V())
+	}
+	if nv := Of("2.55e2", IntegralFloats()).Uint8(); nv.V() != 255 || nv.Err() != nil {
+		t.Errorf("Of(2.55e2).Uint8() failed, expected 255 instead of %v, error %v", nv.V(), nv.Err())
+	}
+	if nv := Of(" 1 234.5 ", TrimSpace(), DigitSeparator(' ')).Float(); nv.V() != 1234.5 || nv.Err() != nil {
+		t.Errorf("Of(1 234.5).Float() failed, expected 1234.5 instead of %v, error %v", nv.V(), nv.Err())
+	}
+}
+
+func TestStringNumber(t *testing.T) {
+	if nv := StringNumber[int](" 1,234 ", TrimSpace(), DigitSeparator(',')); nv.V() != 1234 || nv.Err() != nil {
+		t.Errorf("StringNumber[int]() failed, expected 1234 instead of %v, error %v", nv.V(), nv.Err())
+	}
+	if nv := StringNumber[uint16]("0x1F"); nv.V() != 31 || nv.Err() != nil {
+		t.Errorf("StringNumber[uint16]() failed, expected 31 instead of %v, error %v", nv.V(), nv.Err())
+	}
+	if nv := StringNumber[int8]("1e2", IntegralFloats()); nv.V() != 100 || nv.Err() != nil {
+		t.Errorf("StringNumber[int8]() failed, expected 100 instead of %v, error %v", nv.V(), nv.Err())
+	}
+	if nv := StringNumber[float64]("1'000.5", DigitSeparator('\'')); nv.V() != 1000.5 || nv.Err() != nil {
+		t.Errorf("StringNumber[float64]() failed, expected 1000.5 instead of %v, error %v", nv.V(), nv.Err())
+	}
+}
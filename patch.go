@@ -0,0 +1,514 @@
+package typ
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrPatchTest is returned when patch "test" operation failed
+	ErrPatchTest = ErrorUnexpectedValue(errors.New("patch test operation failed"))
+	// ErrPatchOperation is returned when patch operation is unknown or malformed
+	ErrPatchOperation = ErrorInvalidArgument(errors.New("patch operation is unknown or malformed"))
+)
+
+type patchOpts struct {
+	equals bool
+}
+
+// PatchOption is interface function used as argument value for patch configuration
+type PatchOption func(*patchOpts) error
+
+// PatchEquals set comparison mode of patch "test" operation.
+// If value is true, values compared by Equals semantics (e.g. 1 and 1.0 are equals),
+// otherwise values must be identical
+func PatchEquals(value bool) PatchOption {
+	return func(t *patchOpts) error {
+		t.equals = value
+		return nil
+	}
+}
+
+// PatchOperation represents a single operation of JSON Patch (RFC 6902).
+type PatchOperation struct {
+	Op    string      `json:"op" typ:"op,required"`
+	Path  string      `json:"path" typ:"path,required"`
+	From  string      `json:"from,omitempty" typ:"from"`
+	Value interface{} `json:"value,omitempty" typ:"value"`
+}
+
+// PatchError is returned when patch operation failed
+type PatchError struct {
+	Index     int
+	Operation PatchOperation
+	Err       error
+}
+
+// Error returns description of failed operation
+func (e *PatchError) Error() string {
+	return "patch operation " + strconv.Itoa(e.Index) + " (" + e.Operation.Op + " " + e.Operation.Path + "): " + e.Err.Error()
+}
+
+// Unwrap returns underlying error
+func (e *PatchError) Unwrap() error {
+	return e.Err
+}
+
+// ApplyPatch applies JSON Patch (RFC 6902) to composite type located by pointer doc.
+// Patch can be a JSON document as []byte or string, []PatchOperation or unstructured data decoded from JSON.
+// Paths are JSON Pointers converted to the key type expected by each container like in Type.Pointer.
+// Patch is applied atomically, doc is modified only if all operations succeeded
+func ApplyPatch(doc interface{}, patch interface{}, options ...PatchOption) error {
+	rv := reflect.ValueOf(doc)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return ErrInvalidArgument
+	}
+	var opts patchOpts
+	for _, v := range options {
+		if optErr := v(&opts); optErr != nil {
+			return optErr
+		}
+	}
+	operations, err := patchOperations(patch)
+	if err != nil {
+		return err
+	}
+	target := reflect.New(rv.Elem().Type()).Elem()
+	target.Set(deepCopy(rv.Elem()))
+	for i, v := range operations {
+		if err := applyOperation(target, v, opts); err != nil {
+			return &PatchError{i, v, err}
+		}
+	}
+	rv.Elem().Set(target)
+	return nil
+}
+
+// MergePatch applies JSON Merge Patch (RFC 7386) to composite type located by pointer doc.
+// Patch can be a JSON document as []byte or string or unstructured data decoded from JSON.
+// Maps with string keys and structs are merged, nil values remove keys or reset fields to zero values,
+// other values replace target. Struct fields are matched by name or `json` tag like in Type.Get
+func MergePatch(doc interface{}, patch interface{}) error {
+	rv := reflect.ValueOf(doc)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return ErrInvalidArgument
+	}
+	if b, ok := patchBytes(patch); ok {
+		if err := json.Unmarshal(b, &patch); err != nil {
+			return err
+		}
+	}
+	target := reflect.New(rv.Elem().Type()).Elem()
+	target.Set(deepCopy(rv.Elem()))
+	if err := mergePatch(target, reflect.ValueOf(patch)); err != nil {
+		return err
+	}
+	rv.Elem().Set(target)
+	return nil
+}
+
+// Returns JSON document from patch if it is []byte or string
+func patchBytes(patch interface{}) ([]byte, bool) {
+	switch v := patch.(type) {
+	case []byte:
+		return v, true
+	case string:
+		return []byte(v), true
+	}
+	return nil, false
+}
+
+// Returns operations of JSON Patch from any supported representation
+func patchOperations(patch interface{}) ([]PatchOperation, error) {
+	if operations, ok := patch.([]PatchOperation); ok {
+		return operations, nil
+	}
+	var operations []PatchOperation
+	if b, ok := patchBytes(patch); ok {
+		if err := json.Unmarshal(b, &operations); err != nil {
+			return nil, err
+		}
+		return operations, nil
+	}
+	if err := Decode(patch, &operations); err != nil {
+		return nil, err
+	}
+	return operations, nil
+}
+
+// Apply a single operation of JSON Patch to settable target
+func applyOperation(target reflect.Value, operation PatchOperation, opts patchOpts) error {
+	switch operation.Op {
+	case "add":
+		return patchAdd(target, operation.Path, operation.Value)
+	case "remove":
+		return patchRemove(target, operation.Path)
+	case "replace":
+		keys, err := resolvePointer(target, operation.Path, false)
+		if err != nil {
+			return err
+		}
+		return modifyPath(target, keys, false, func(dst reflect.Value) error {
+			return assignValue(dst, operation.Value)
+		})
+	case "move":
+		if operation.Path != operation.From && strings.HasPrefix(operation.Path, operation.From+"/") {
+			return ErrPatchOperation
+		}
+		value, err := patchGet(target, operation.From)
+		if err != nil {
+			return err
+		}
+		if err = patchRemove(target, operation.From); err != nil {
+			return err
+		}
+		return patchAdd(target, operation.Path, value)
+	case "copy":
+		value, err := patchGet(target, operation.From)
+		if err != nil {
+			return err
+		}
+		if value != nil {
+			value = deepCopy(reflect.ValueOf(value)).Interface()
+		}
+		return patchAdd(target, operation.Path, value)
+	case "test":
+		value, err := patchGet(target, operation.Path)
+		if err != nil {
+			return err
+		}
+		if !patchEqual(reflect.ValueOf(value), reflect.ValueOf(operation.Value), opts.equals) {
+			return ErrPatchTest
+		}
+		return nil
+	}
+	return ErrPatchOperation
+}
+
+// Returns value located by JSON Pointer
+func patchGet(target reflect.Value, pointer string) (interface{}, error) {
+	keys, err := resolvePointer(target, pointer, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return target.Interface(), nil
+	}
+	p := target
+	for _, key := range keys[:len(keys)-1] {
+		if p, err = getIndexSafe(p, key); err != nil {
+			return nil, err
+		}
+	}
+	return patchMember(p, keys[len(keys)-1])
+}
+
+// Returns member of composite value by index key, members present with nil value are returned as nil.
+// Returns ErrOutOfBounds if member isn't present
+func patchMember(p reflect.Value, key interface{}) (interface{}, error) {
+	v, err := getIndexSafe(p, key)
+	if err == nil {
+		return v.Interface(), nil
+	}
+	if err != ErrOutOfBounds {
+		return nil, err
+	}
+	switch p = indirectComposite(p); {
+	case !p.IsValid():
+	case p.Kind() == reflect.Map:
+		if p.MapIndex(reflect.ValueOf(key)).IsValid() {
+			return nil, nil
+		}
+	case p.Kind() == reflect.Slice, p.Kind() == reflect.Array:
+		return nil, nil
+	case p.Kind() == reflect.Struct:
+		if _, fieldErr := structField(p, key.(string)); fieldErr == nil {
+			return nil, nil
+		}
+	}
+	return nil, err
+}
+
+// Add value located by JSON Pointer, value is inserted into arrays and slices
+func patchAdd(target reflect.Value, pointer string, value interface{}) error {
+	keys, err := resolvePointer(target, pointer, true)
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return assignValue(target, value)
+	}
+	last := keys[len(keys)-1]
+	return modifyPath(target, keys[:len(keys)-1], false, func(dst reflect.Value) error {
+		return insertIndex(dst, last, value)
+	})
+}
+
+// Remove value located by JSON Pointer
+func patchRemove(target reflect.Value, pointer string) error {
+	keys, err := resolvePointer(target, pointer, false)
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		target.Set(reflect.Zero(target.Type()))
+		return nil
+	}
+	last := keys[len(keys)-1]
+	return modifyPath(target, keys[:len(keys)-1], false, func(dst reflect.Value) error {
+		return deleteIndex(dst, last)
+	})
+}
+
+// Resolve JSON Pointer into index keys converted to the key type expected by each container.
+// If insert is true, the last segment "-" of array or slice refers to the index after the last element
+func resolvePointer(target reflect.Value, pointer string, insert bool) ([]interface{}, error) {
+	keys, err := parsePointer(pointer)
+	if err != nil {
+		return nil, err
+	}
+	p := target
+	for i, v := range keys {
+		container := indirectComposite(p)
+		if insert && i == len(keys)-1 && v == "-" && (container.Kind() == reflect.Slice || container.Kind() == reflect.Array) {
+			keys[i] = container.Len()
+			break
+		}
+		if keys[i], err = coerceKey(p, v); err != nil {
+			return nil, err
+		}
+		if i == len(keys)-1 {
+			break
+		}
+		if p, err = getIndexSafe(p, keys[i]); err != nil {
+			return nil, err
+		}
+	}
+	return keys, nil
+}
+
+// Insert value into settable composite value by index key.
+// Elements of slices are shifted, map keys and struct fields are replaced
+func insertIndex(dst reflect.Value, key interface{}, value interface{}) error {
+	switch dst.Kind() {
+	case reflect.Interface, reflect.Ptr:
+		if dst.IsNil() {
+			return ErrOutOfBounds
+		}
+		if dst.Kind() == reflect.Ptr {
+			return insertIndex(dst.Elem(), key, value)
+		}
+		v := reflect.New(dst.Elem().Type()).Elem()
+		v.Set(dst.Elem())
+		if err := insertIndex(v, key, value); err != nil {
+			return err
+		}
+		dst.Set(v)
+	case reflect.Slice:
+		index, ok := key.(int)
+		if !ok {
+			return ErrInvalidArgument
+		}
+		if index < 0 || index > dst.Len() {
+			return ErrOutOfRange
+		}
+		elem := reflect.New(dst.Type().Elem()).Elem()
+		if err := assignValue(elem, value); err != nil {
+			return err
+		}
+		v := reflect.MakeSlice(dst.Type(), 0, dst.Len()+1)
+		v = reflect.AppendSlice(v, dst.Slice(0, index))
+		v = reflect.Append(v, elem)
+		v = reflect.AppendSlice(v, dst.Slice(index, dst.Len()))
+		dst.Set(v)
+	case reflect.Map:
+		if dst.IsNil() {
+			dst.Set(reflect.MakeMap(dst.Type()))
+		}
+		return modifyPath(dst, []interface{}{key}, true, func(dst reflect.Value) error {
+			return assignValue(dst, value)
+		})
+	case reflect.Array, reflect.Struct:
+		return modifyPath(dst, []interface{}{key}, false, func(dst reflect.Value) error {
+			return assignValue(dst, value)
+		})
+	default:
+		return ErrUnexpectedValue
+	}
+	return nil
+}
+
+// Determine whether values are equal, if equals is true primitives compared by Equals semantics
+func patchEqual(a, b reflect.Value, equals bool) bool {
+	a, b = indirectValue(a), indirectValue(b)
+	if !a.IsValid() || !b.IsValid() {
+		return a.IsValid() == b.IsValid()
+	}
+	if !equals {
+		return reflect.DeepEqual(a.Interface(), b.Interface())
+	}
+	switch {
+	case (a.Kind() == reflect.Slice || a.Kind() == reflect.Array) && (b.Kind() == reflect.Slice || b.Kind() == reflect.Array):
+		if a.Len() != b.Len() {
+			return false
+		}
+		for i := 0; i < a.Len(); i++ {
+			if !patchEqual(a.Index(i), b.Index(i), equals) {
+				return false
+			}
+		}
+		return true
+	case a.Kind() == reflect.Map && b.Kind() == reflect.Map:
+		if a.Len() != b.Len() || a.Type().Key() != b.Type().Key() {
+			return false
+		}
+		iter := a.MapRange()
+		for iter.Next() {
+			bv := b.MapIndex(iter.Key())
+			if !bv.IsValid() || !patchEqual(iter.Value(), bv, equals) {
+				return false
+			}
+		}
+		return true
+	case isComposite(a.Kind()) || isComposite(b.Kind()):
+		return false
+	}
+	return Of(a.Interface()).Equals(b.Interface()).V()
+}
+
+// Apply JSON Merge Patch to settable target
+func mergePatch(target reflect.Value, patch reflect.Value) error {
+	if patch = indirectValue(patch); !patch.IsValid() {
+		return assignValue(target, nil)
+	} else if !isObject(patch) {
+		return assignValue(target, deepCopy(patch).Interface())
+	}
+	switch target.Kind() {
+	case reflect.Ptr:
+		if !target.IsNil() {
+			return mergePatch(target.Elem(), patch)
+		}
+		v := reflect.New(target.Type().Elem())
+		if err := mergePatch(v.Elem(), patch); err != nil {
+			return err
+		}
+		target.Set(v)
+	case reflect.Interface:
+		v := reflect.New(reflect.TypeOf(map[string]interface{}{})).Elem()
+		if elem := target.Elem(); isObject(elem) {
+			v = reflect.New(elem.Type()).Elem()
+			v.Set(elem)
+		}
+		if err := mergePatch(v, patch); err != nil {
+			return err
+		}
+		target.Set(v)
+	case reflect.Map:
+		if !isObject(target) {
+			return ErrUnexpectedValue
+		}
+		if target.IsNil() {
+			target.Set(reflect.MakeMap(target.Type()))
+		}
+		iter := patch.MapRange()
+		for iter.Next() {
+			key := reflect.ValueOf(iter.Key().String()).Convert(target.Type().Key())
+			if !indirectValue(iter.Value()).IsValid() {
+				target.SetMapIndex(key, reflect.Value{})
+				continue
+			}
+			v := reflect.New(target.Type().Elem()).Elem()
+			if ev := target.MapIndex(key); ev.IsValid() {
+				v.Set(ev)
+			}
+			if err := mergePatch(v, iter.Value()); err != nil {
+				return err
+			}
+			target.SetMapIndex(key, v)
+		}
+	case reflect.Struct:
+		iter := patch.MapRange()
+		for iter.Next() {
+			field, err := structField(target, iter.Key().String())
+			if err != nil {
+				return err
+			}
+			if !indirectValue(iter.Value()).IsValid() {
+				field.Set(reflect.Zero(field.Type()))
+				continue
+			}
+			if err := mergePatch(field, iter.Value()); err != nil {
+				return err
+			}
+		}
+	default:
+		return ErrUnexpectedValue
+	}
+	return nil
+}
+
+// Determine whether a value is JSON object represented as map with string keys
+func isObject(rv reflect.Value) bool {
+	return rv.IsValid() && rv.Kind() == reflect.Map && isString(rv.Type().Key().Kind())
+}
+
+// Returns deep copy of maps, slices, arrays, pointers and interfaces, other values are copied as is
+func deepCopy(rv reflect.Value) reflect.Value {
+	if !rv.IsValid() {
+		return rv
+	}
+	switch rv.Kind() {
+	case reflect.Interface:
+		if rv.IsNil() {
+			return rv
+		}
+		v := reflect.New(rv.Type()).Elem()
+		v.Set(deepCopy(rv.Elem()))
+		return v
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return rv
+		}
+		v := reflect.New(rv.Type().Elem())
+		v.Elem().Set(deepCopy(rv.Elem()))
+		return v
+	case reflect.Map:
+		if rv.IsNil() {
+			return rv
+		}
+		v := reflect.MakeMapWithSize(rv.Type(), rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			v.SetMapIndex(iter.Key(), deepCopy(iter.Value()))
+		}
+		return v
+	case reflect.Slice:
+		if rv.IsNil() {
+			return rv
+		}
+		v := reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			v.Index(i).Set(deepCopy(rv.Index(i)))
+		}
+		return v
+	case reflect.Array:
+		v := reflect.New(rv.Type()).Elem()
+		for i := 0; i < rv.Len(); i++ {
+			v.Index(i).Set(deepCopy(rv.Index(i)))
+		}
+		return v
+	case reflect.Struct:
+		v := reflect.New(rv.Type()).Elem()
+		v.Set(rv)
+		for i := 0; i < rv.NumField(); i++ {
+			if v.Field(i).CanSet() {
+				v.Field(i).Set(deepCopy(rv.Field(i)))
+			}
+		}
+		return v
+	}
+	return rv
+}
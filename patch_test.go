@@ -0,0 +1,175 @@
+package typ
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestApplyPatch(t *testing.T) {
+	testData := []struct {
+		doc      interface{}
+		patch    interface{}
+		expected interface{}
+		err      error
+	}{
+		{
+			map[string]interface{}{"a": []interface{}{1, 2}},
+			`[{"op":"add","path":"/a/1","value":3},{"op":"add","path":"/a/-","value":4},{"op":"add","path":"/b","value":"c"}]`,
+			map[string]interface{}{"a": []interface{}{1, float64(3), 2, float64(4)}, "b": "c"},
+			nil,
+		},
+		{
+			map[string]interface{}{"a": []interface{}{1, 2}, "b": 1},
+			[]PatchOperation{{Op: "remove", Path: "/a/0"}, {Op: "replace", Path: "/b", Value: "2"}},
+			map[string]interface{}{"a": []interface{}{2}, "b": "2"},
+			nil,
+		},
+		{
+			map[string]interface{}{"a": map[string]interface{}{"b": 1}, "c": []interface{}{}},
+			[]interface{}{
+				map[string]interface{}{"op": "copy", "from": "/a/b", "path": "/c/-"},
+				map[string]interface{}{"op": "move", "from": "/a/b", "path": "/d"},
+			},
+			map[string]interface{}{"a": map[string]interface{}{}, "c": []interface{}{1}, "d": 1},
+			nil,
+		},
+		{
+			// typed containers, keys and values converted
+			map[int][]int{1: {1}},
+			`[{"op":"add","path":"/1/0","value":"0"},{"op":"add","path":"/2","value":[3]}]`,
+			map[int][]int{1: {0, 1}, 2: {3}},
+			nil,
+		},
+		{
+			map[string]interface{}{"a": 1},
+			`[{"op":"test","path":"/a","value":1}]`,
+			map[string]interface{}{"a": 1},
+			ErrPatchTest,
+		},
+		{
+			// atomic, first operation is rolled back
+			map[string]interface{}{"a": 1},
+			`[{"op":"add","path":"/b","value":2},{"op":"remove","path":"/c"}]`,
+			map[string]interface{}{"a": 1},
+			ErrOutOfBounds,
+		},
+		{
+			map[string]interface{}{"a": map[string]interface{}{}},
+			`[{"op":"move","from":"/a","path":"/a/b"}]`,
+			map[string]interface{}{"a": map[string]interface{}{}},
+			ErrPatchOperation,
+		},
+		{
+			map[string]interface{}{"a": []interface{}{}},
+			`[{"op":"add","path":"/a/1","value":1}]`,
+			map[string]interface{}{"a": []interface{}{}},
+			ErrOutOfRange,
+		},
+		{
+			// null members are present
+			map[string]interface{}{"foo": nil, "bar": []interface{}{nil}},
+			`[{"op":"test","path":"/foo","value":null},{"op":"test","path":"/bar/0","value":null},` +
+				`{"op":"copy","from":"/foo","path":"/baz"},{"op":"move","from":"/bar/0","path":"/qux"}]`,
+			map[string]interface{}{"foo": nil, "bar": []interface{}{}, "baz": nil, "qux": nil},
+			nil,
+		},
+		{
+			map[string]interface{}{"foo": nil},
+			`[{"op":"test","path":"/bar","value":null}]`,
+			map[string]interface{}{"foo": nil},
+			ErrOutOfBounds,
+		},
+		{
+			map[string]interface{}{},
+			`[{"op":"unknown","path":"/a"}]`,
+			map[string]interface{}{},
+			ErrPatchOperation,
+		},
+	}
+	for _, v := range testData {
+		doc := reflect.New(reflect.TypeOf(v.doc))
+		doc.Elem().Set(deepCopy(reflect.ValueOf(v.doc)))
+		err := ApplyPatch(doc.Interface(), v.patch)
+		if !errors.Is(err, v.err) || (v.err == nil) != (err == nil) || !reflect.DeepEqual(doc.Elem().Interface(), v.expected) {
+			t.Errorf("ApplyPatch(%v, %v) failed, expected (expected == actual) %v == %v, error (%v == %v)",
+				v.doc, v.patch, v.expected, doc.Elem().Interface(), v.err, err,
+			)
+		}
+	}
+	doc := map[string]interface{}{"a": []interface{}{1, uint8(2)}}
+	patch := `[{"op":"test","path":"/a","value":[1.0,2]}]`
+	if err := ApplyPatch(&doc, patch, PatchEquals(true)); err != nil {
+		t.Errorf("ApplyPatch(%v, %v, PatchEquals(true)) failed, unexpected error %v", doc, patch, err)
+	}
+	var patchErr *PatchError
+	if err := ApplyPatch(&doc, patch); !errors.As(err, &patchErr) || patchErr.Index != 0 || patchErr.Err != ErrPatchTest {
+		t.Errorf("ApplyPatch(%v, %v) failed, expected error %v instead of %v", doc, patch, ErrPatchTest, err)
+	}
+	if err := ApplyPatch(doc, patch); err != ErrInvalidArgument {
+		t.Errorf("ApplyPatch(%v, %v) failed, expected error %v instead of %v", doc, patch, ErrInvalidArgument, err)
+	}
+}
+
+func TestMergePatch(t *testing.T) {
+	testData := []struct {
+		doc      interface{}
+		patch    interface{}
+		expected interface{}
+		err      error
+	}{
+		{
+			map[string]interface{}{"a": "b", "c": map[string]interface{}{"d": "e", "f": "g"}},
+			`{"a":"z","c":{"f":null}}`,
+			map[string]interface{}{"a": "z", "c": map[string]interface{}{"d": "e"}},
+			nil,
+		},
+		{
+			map[string]interface{}{"a": []interface{}{"b"}},
+			map[string]interface{}{"a": "c", "b": map[string]interface{}{"c": nil, "d": 1}},
+			map[string]interface{}{"a": "c", "b": map[string]interface{}{"d": 1}},
+			nil,
+		},
+		{
+			map[string]int{"a": 1, "b": 2},
+			`{"a":null,"b":"3"}`,
+			map[string]int{"b": 3},
+			nil,
+		},
+		{
+			map[string]interface{}{"a": 1},
+			`null`,
+			map[string]interface{}(nil),
+			nil,
+		},
+		{
+			// struct fields by name and json tag
+			StructFieldsType{Name: "name", Skipped: "skipped", Map: map[string]interface{}{"a": 1}},
+			`{"name":"gopher","Skipped":null,"map":{"a":null,"b":2}}`,
+			StructFieldsType{Name: "gopher", Map: map[string]interface{}{"b": float64(2)}},
+			nil,
+		},
+		{
+			StructFieldsType{},
+			`{"invalid":1}`,
+			StructFieldsType{},
+			ErrOutOfBounds,
+		},
+		{
+			[]interface{}{1},
+			`{"a":1}`,
+			[]interface{}{1},
+			ErrUnexpectedValue,
+		},
+	}
+	for _, v := range testData {
+		doc := reflect.New(reflect.TypeOf(v.doc))
+		doc.Elem().Set(deepCopy(reflect.ValueOf(v.doc)))
+		err := MergePatch(doc.Interface(), v.patch)
+		if err != v.err || !reflect.DeepEqual(doc.Elem().Interface(), v.expected) {
+			t.Errorf("MergePatch(%v, %v) failed, expected (expected == actual) %v == %v, error (%v == %v)",
+				v.doc, v.patch, v.expected, doc.Elem().Interface(), v.err, err,
+			)
+		}
+	}
+}
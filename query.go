@@ -0,0 +1,197 @@
+package typ
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Match is a value found by query
+type Match struct {
+	// Path is the concrete path of value in dot notation
+	Path string
+	// Keys is the concrete index keys of value, can be used with Get
+	Keys []interface{}
+	// Value is the found value
+	Value *Type
+}
+
+type query struct {
+	segments []string
+	opts     opts
+	matches  []Match
+	seen     map[string]bool
+	visiting map[visit]bool
+}
+
+// visit is identity of pointer, map or slice on the current walk, slices are identified with length
+type visit struct {
+	kind reflect.Kind
+	ptr  uintptr
+	len  int
+}
+
+// Query retrieve all values from composite type matched by query in dot notation.
+// Segment "*" matches any key of array, slice, map or struct and
+// segment "**" matches any number of nested levels including none, e.g. "items.*.price" or "**.id".
+// Other segments are converted to the key type expected by each container like in Path.
+// Missed keys are skipped, maps are walked in sorted order of keys
+func (t *Type) Query(path string) ([]Match, error) {
+	if t.err != nil {
+		return nil, t.err
+	}
+	if !t.rv.IsValid() {
+		return nil, ErrInvalidArgument
+	}
+	keys, err := parseDotPath(path)
+	if err != nil {
+		return nil, err
+	}
+	q := &query{opts: t.opts, seen: map[string]bool{}, visiting: map[visit]bool{}}
+	for _, v := range keys {
+		q.segments = append(q.segments, v.(string))
+	}
+	q.walk(t.rv, nil, 0)
+	return q.matches, nil
+}
+
+// Walk value matching segments starting from segment with index i
+func (q *query) walk(p reflect.Value, keys []interface{}, i int) {
+	if i == len(q.segments) {
+		q.match(p, keys)
+		return
+	}
+	switch segment := q.segments[i]; segment {
+	case "**":
+		q.walk(p, keys, i+1)
+		if v, ok := visitOf(p); ok {
+			if q.visiting[v] {
+				return
+			}
+			q.visiting[v] = true
+			defer delete(q.visiting, v)
+		}
+		q.children(p, func(key interface{}, child reflect.Value) {
+			q.walk(child, appendKey(keys, key), i)
+		})
+	case "*":
+		q.children(p, func(key interface{}, child reflect.Value) {
+			q.walk(child, appendKey(keys, key), i+1)
+		})
+	default:
+		key, err := coerceKey(p, segment)
+		if err != nil {
+			return
+		}
+		if child, err := getIndexSafe(p, key); err == nil {
+			q.walk(child, appendKey(keys, key), i+1)
+		}
+	}
+}
+
+// Returns identity of pointer, map or non-empty slice held by value, false is returned for other values
+func visitOf(p reflect.Value) (visit, bool) {
+	for p.Kind() == reflect.Interface && !p.IsNil() {
+		p = p.Elem()
+	}
+	switch p.Kind() {
+	case reflect.Ptr, reflect.Map:
+		return visit{kind: p.Kind(), ptr: p.Pointer()}, p.Pointer() != 0
+	case reflect.Slice:
+		return visit{kind: p.Kind(), ptr: p.Pointer(), len: p.Len()}, p.Pointer() != 0 && p.Len() > 0
+	}
+	return visit{}, false
+}
+
+// Register matched value, the same path is registered once
+func (q *query) match(p reflect.Value, keys []interface{}) {
+	path := keyPath(keys)
+	if q.seen[path] {
+		return
+	}
+	q.seen[path] = true
+	typed := &Type{
+		rv:   reflect.ValueOf(p.Interface()),
+		opts: q.opts,
+		path: keys,
+	}
+	typed.kind = typed.rv.Kind()
+	q.matches = append(q.matches, Match{path, keys, typed})
+}
+
+// Call fn for every non-nil child of composite value
+func (q *query) children(p reflect.Value, fn func(key interface{}, child reflect.Value)) {
+	p = indirectComposite(p)
+	if !p.IsValid() {
+		return
+	}
+	switch p.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < p.Len(); i++ {
+			if child, err := getIndex(p, i); err == nil {
+				fn(i, child)
+			}
+		}
+	case reflect.Map:
+		mapKeys := p.MapKeys()
+		sort.Slice(mapKeys, func(i, j int) bool {
+			return lessKey(mapKeys[i], mapKeys[j])
+		})
+		for _, key := range mapKeys {
+			if !key.CanInterface() {
+				continue
+			}
+			if child, err := getIndex(p, key.Interface()); err == nil {
+				fn(key.Interface(), child)
+			}
+		}
+	case reflect.Struct:
+		for _, sf := range reflect.VisibleFields(p.Type()) {
+			if sf.PkgPath != "" || (sf.Anonymous && indirectType(sf.Type).Kind() == reflect.Struct) {
+				continue
+			}
+			if child, err := getIndex(p, sf.Name); err == nil {
+				fn(sf.Name, child)
+			}
+		}
+	}
+}
+
+// Retrieve value from composite value by index key, panics are returned as ErrInvalidArgument
+func getIndexSafe(p reflect.Value, key interface{}) (v reflect.Value, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = ErrInvalidArgument
+		}
+	}()
+	return getIndex(p, key)
+}
+
+// Determine whether a map key is less than other
+func lessKey(a, b reflect.Value) bool {
+	a, b = indirectValue(a), indirectValue(b)
+	switch {
+	case isInt(a.Kind()) && isInt(b.Kind()):
+		return a.Int() < b.Int()
+	case isUint(a.Kind()) && isUint(b.Kind()):
+		return a.Uint() < b.Uint()
+	case isFloat(a.Kind()) && isFloat(b.Kind()):
+		return a.Float() < b.Float()
+	case isString(a.Kind()) && isString(b.Kind()):
+		return a.String() < b.String()
+	}
+	return fmt.Sprint(a) < fmt.Sprint(b)
+}
+
+// Returns type dereferenced by pointers
+func indirectType(rt reflect.Type) reflect.Type {
+	for rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	return rt
+}
+
+// Returns copy of keys with appended key
+func appendKey(keys []interface{}, key interface{}) []interface{} {
+	return append(append(make([]interface{}, 0, len(keys)+1), keys...), key)
+}
@@ -0,0 +1,67 @@
+package typ
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestQuery(t *testing.T) {
+	cyclic := map[string]interface{}{"id": 7}
+	cyclic["self"] = cyclic
+	data := map[string]interface{}{
+		"id": 1,
+		"items": []interface{}{
+			map[string]interface{}{"id": 2, "price": 10},
+			map[string]interface{}{"id": 3},
+			nil,
+			&StructFieldsType{Name: "struct", Map: map[string]interface{}{"price": 30, "id": 4}},
+			map[string]interface{}{"id": 5, "price": "20"},
+		},
+		"index":  map[int]string{10: "ten", 2: "two"},
+		"cyclic": cyclic,
+	}
+	testData := []struct {
+		query string
+		paths []string
+		err   error
+	}{
+		{"items.*.price", []string{"items.0.price", "items.4.price"}, nil},
+		{"items.*.map.price", []string{"items.3.map.price"}, nil},
+		{"items.1.*", []string{"items.1.id"}, nil},
+		{"items.3.*", []string{"items.3.Name", "items.3.Skipped", "items.3.Nested", "items.3.Map"}, nil},
+		{"index.*", []string{"index.2", "index.10"}, nil},
+		{"**.id", []string{"id", "cyclic.id", "cyclic.self.id", "items.0.id", "items.1.id", "items.3.Map.id", "items.4.id"}, nil},
+		{"**.price", []string{"items.0.price", "items.3.Map.price", "items.4.price"}, nil},
+		{"items.**.price", []string{"items.0.price", "items.3.Map.price", "items.4.price"}, nil},
+		{"items.**.**.price", []string{"items.0.price", "items.3.Map.price", "items.4.price"}, nil},
+		{"items.9.price", nil, nil},
+		{"id.*", nil, nil},
+		{"items..price", nil, ErrInvalidArgument},
+		{"", nil, ErrInvalidArgument},
+	}
+	for _, v := range testData {
+		matches, err := Of(data).Query(v.query)
+		paths := make([]string, 0, len(matches))
+		for _, m := range matches {
+			paths = append(paths, m.Path)
+			if expected := Of(data).Get(m.Keys...).Interface(); !reflect.DeepEqual(expected.V(), m.Value.Interface().V()) {
+				t.Errorf("Of(data).Query(%q) failed, match %q differs from Get(%v)", v.query, m.Path, m.Keys)
+			}
+		}
+		if err != v.err || (len(paths) > 0 || len(v.paths) > 0) && !reflect.DeepEqual(paths, v.paths) {
+			t.Errorf("Of(data).Query(%q) failed, expected (expected == actual) %v == %v, error %v", v.query, v.paths, paths, err)
+		}
+	}
+	list := []interface{}{nil, 1}
+	list[0] = list
+	if matches, err := Of(list).Query("**"); err != nil || len(matches) != 3 {
+		t.Errorf("Of(list).Query(\"**\") failed, cyclic slice must be walked once instead of %d matches, error %v", len(matches), err)
+	}
+	matches, _ := Of(data).Query("items.*.price")
+	if len(matches) != 2 || matches[0].Value.Int().V() != 10 || matches[1].Value.Int().V() != 20 {
+		t.Error("Of(data).Query(\"items.*.price\") failed, accessors must be applied to matches")
+	}
+	if _, err := Of(nil).Query("*"); err != ErrInvalidArgument {
+		t.Errorf("Of(nil).Query(\"*\") failed, expected error %v instead of %v", ErrInvalidArgument, err)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"math"
 	"math/big"
 	"reflect"
+	"strconv"
+	"time"
 )
 
 var complexFloatMap = map[reflect.Kind]reflect.Kind{
@@ -298,3 +300,81 @@ func (t *Type) Kind(indirect ...bool) reflect.Kind {
 	}
 	return t.kind
 }
+
+// Determine reason why a value can't safely convert to kind
+func conversionReason(rv reflect.Value, to reflect.Kind, err error) ConversionReason {
+	if numErr, ok := err.(*strconv.NumError); ok {
+		if numErr.Err == strconv.ErrRange {
+			return ReasonOverflow
+		}
+		return ReasonSyntax
+	}
+	if _, ok := err.(*time.ParseError); ok {
+		return ReasonSyntax
+	}
+	if !rv.IsValid() || !isNumeric(to) {
+		return ReasonUnsupported
+	}
+	if r, ok := numberRat(rv); ok {
+		return ratReason(r, to)
+	}
+	var from float64
+	switch kind := rv.Kind(); {
+	case isString(kind):
+		return ReasonSyntax
+	case isInt(kind):
+		from = float64(rv.Int())
+	case isUint(kind):
+		from = float64(rv.Uint())
+	case isFloat(kind):
+		from = rv.Float()
+	case isComplex(kind):
+		if imag(rv.Complex()) != 0 && !isComplex(to) {
+			return ReasonImaginary
+		}
+		from = real(rv.Complex())
+	default:
+		return ReasonUnsupported
+	}
+	switch {
+	case from < 0 && isUint(to):
+		return ReasonNegative
+	case math.IsNaN(from):
+		return ReasonPrecision
+	case isFloat(to) || isComplex(to):
+		if bitSizeMap[to] <= 32 && math.Abs(from) > math.MaxFloat32 && !math.IsInf(from, 0) {
+			return ReasonOverflow
+		}
+		return ReasonPrecision
+	case (isFloat(rv.Kind()) || isComplex(rv.Kind())) && from != math.Trunc(from):
+		return ReasonPrecision
+	case isInt(to) && (from < -math.Ldexp(1, bitSizeMap[to]-1) || from >= math.Ldexp(1, bitSizeMap[to]-1)):
+		return ReasonOverflow
+	case isUint(to) && from >= math.Ldexp(1, bitSizeMap[to]):
+		return ReasonOverflow
+	case isFloat(rv.Kind()) || isComplex(rv.Kind()):
+		return ReasonPrecision
+	}
+	return ReasonOverflow
+}
+
+// Determine reason why a rational value of big number or Decimal can't safely convert to kind
+func ratReason(r *big.Rat, to reflect.Kind) ConversionReason {
+	switch {
+	case r.Sign() < 0 && isUint(to):
+		return ReasonNegative
+	case isFloat(to) || isComplex(to):
+		f, _ := r.Float64()
+		if bitSizeMap[to] <= 32 {
+			f32, _ := r.Float32()
+			f = float64(f32)
+		}
+		if math.IsInf(f, 0) {
+			return ReasonOverflow
+		}
+		return ReasonPrecision
+	case !r.IsInt():
+		return ReasonPrecision
+	}
+	return ReasonOverflow
+}
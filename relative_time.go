@@ -0,0 +1,235 @@
+package typ
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+)
+
+// relativeUnits are units of relative time from the smallest to the largest, months & years have fixed length of 30 & 365 days
+var relativeUnits = []time.Duration{
+	time.Second, time.Minute, time.Hour, 24 * time.Hour, 7 * 24 * time.Hour, 30 * 24 * time.Hour, 365 * 24 * time.Hour,
+}
+
+// defaultRelativeThresholds are counts of units before the next larger unit is used
+var defaultRelativeThresholds = map[time.Duration]int64{
+	time.Second: 60, time.Minute: 60, time.Hour: 24, 24 * time.Hour: 7, 7 * 24 * time.Hour: 4, 30 * 24 * time.Hour: 12,
+}
+
+// RelativeTimeLocale describes phrases of relative time in a locale
+type RelativeTimeLocale struct {
+	// Past & Future are formats of phrases with %s verb for amount, e.g. "%s ago" & "in %s"
+	Past, Future string
+	// Now is a phrase for difference less than granularity
+	Now string
+	// Units are word forms of relative units: time.Second, time.Minute, time.Hour, 24h, 7*24h, 30*24h & 365*24h
+	Units map[time.Duration][]string
+	// Plural returns index of word form for count, the first form is used for 1 & the second one otherwise if it's nil
+	Plural func(n int64) int
+}
+
+var (
+	relativeLocalesMu sync.RWMutex
+	relativeLocales   = map[string]RelativeTimeLocale{
+		"en": {
+			Past:   "%s ago",
+			Future: "in %s",
+			Now:    "just now",
+			Units: map[time.Duration][]string{
+				time.Second:          {"second", "seconds"},
+				time.Minute:          {"minute", "minutes"},
+				time.Hour:            {"hour", "hours"},
+				24 * time.Hour:       {"day", "days"},
+				7 * 24 * time.Hour:   {"week", "weeks"},
+				30 * 24 * time.Hour:  {"month", "months"},
+				365 * 24 * time.Hour: {"year", "years"},
+			},
+		},
+	}
+)
+
+// RegisterRelativeLocale register phrases of relative time under the given name, registered locale replaces existing one.
+// Returns ErrInvalidArgument if formats don't have %s verb or word forms of any unit are missed
+func RegisterRelativeLocale(name string, locale RelativeTimeLocale) error {
+	if name == "" || strings.Count(locale.Past, "%s") != 1 || strings.Count(locale.Future, "%s") != 1 {
+		return ErrInvalidArgument
+	}
+	for _, unit := range relativeUnits {
+		if len(locale.Units[unit]) == 0 {
+			return ErrInvalidArgument
+		}
+	}
+	relativeLocalesMu.Lock()
+	defer relativeLocalesMu.Unlock()
+	relativeLocales[name] = locale
+	return nil
+}
+
+// RelativeLocale set locale of relative time phrases by name, "en" is used by default.
+// Returns ErrInvalidArgument if locale isn't registered
+func RelativeLocale(name string) Option {
+	return func(t *opts) error {
+		relativeLocalesMu.RLock()
+		defer relativeLocalesMu.RUnlock()
+		l, ok := relativeLocales[name]
+		if !ok {
+			return ErrInvalidArgument
+		}
+		t.relative = &l
+		return nil
+	}
+}
+
+// RelativeGranularity set the smallest unit of relative time, smaller differences are humanized as now.
+// The unit must be one of time.Second, time.Minute, time.Hour, 24h, 7*24h, 30*24h or 365*24h, time.Second is used by default
+func RelativeGranularity(unit time.Duration) Option {
+	return func(t *opts) error {
+		if relativeUnitIndex(unit) < 0 {
+			return ErrInvalidArgument
+		}
+		t.granularity = unit
+		return nil
+	}
+}
+
+// RelativeThreshold set count of units before the next larger unit is used, e.g. RelativeThreshold(time.Hour, 48)
+// humanizes 30 hours as "30 hours ago" instead of "1 day ago". The unit must be one of relative units except years
+func RelativeThreshold(unit time.Duration, count int64) Option {
+	return func(t *opts) error {
+		if i := relativeUnitIndex(unit); i < 0 || i == len(relativeUnits)-1 || count < 1 {
+			return ErrInvalidArgument
+		}
+		thresholds := make(map[time.Duration]int64, len(defaultRelativeThresholds))
+		for k, v := range defaultRelativeThresholds {
+			thresholds[k] = v
+		}
+		for k, v := range t.thresholds {
+			thresholds[k] = v
+		}
+		thresholds[unit] = count
+		t.thresholds = thresholds
+		return nil
+	}
+}
+
+// RelativeTo set time which relative time is humanized against, current time is used by default
+func RelativeTo(now time.Time) Option {
+	return func(t *opts) error {
+		t.now = &now
+		return nil
+	}
+}
+
+// HumanizeTime convert time to phrase relative to now, e.g. "3 hours ago" or "in 2 days"
+func HumanizeTime(from, now time.Time, options ...Option) StringAccessor {
+	nv := &NullString{}
+	t := Of(from, options...)
+	if nv.Error = t.err; t.err != nil {
+		return nv
+	}
+	v := relativeTime(from, now, t.opts)
+	nv.P = &v
+	return nv
+}
+
+// TimeAgo convert interface value to time & humanize it relative to current time or RelativeTo option,
+// e.g. "3 hours ago" or "in 2 days". Values are converted to time by the same rules as Time
+func (t *Type) TimeAgo() StringAccessor {
+	nv := &NullString{}
+	if nv.Error = t.err; t.err != nil {
+		return nv
+	}
+	tv := t.toTime()
+	if nv.Error = tv.Err(); nv.Error != nil {
+		return nv
+	}
+	now := time.Now()
+	if t.opts.now != nil {
+		now = *t.opts.now
+	}
+	v := relativeTime(tv.V(), now, t.opts)
+	nv.P = &v
+	return nv
+}
+
+// Humanize difference of time by granularity, thresholds & locale of options
+func relativeTime(from, now time.Time, o opts) string {
+	var locale RelativeTimeLocale
+	if o.relative != nil {
+		locale = *o.relative
+	} else {
+		relativeLocalesMu.RLock()
+		locale = relativeLocales["en"]
+		relativeLocalesMu.RUnlock()
+	}
+	thresholds := o.thresholds
+	if thresholds == nil {
+		thresholds = defaultRelativeThresholds
+	}
+	d, format := from.Sub(now), locale.Future
+	if d < 0 {
+		format = locale.Past
+	}
+	i, n := len(relativeUnits)-1, int64(0)
+	if d == math.MinInt64 || d == math.MaxInt64 {
+		// Difference beyond range of duration (about 292 years) is counted in calendar years
+		n = calendarYears(from, now)
+	} else {
+		if d < 0 {
+			d = -d
+		}
+		i = 0
+		if o.granularity != 0 {
+			i = relativeUnitIndex(o.granularity)
+		}
+		if d < relativeUnits[i] {
+			return locale.Now
+		}
+		for ; i < len(relativeUnits)-1; i++ {
+			if unit := relativeUnits[i]; int64(d/unit) < thresholds[unit] {
+				break
+			}
+		}
+		// Difference less than the larger unit reached by lowered threshold is counted as one unit
+		n = int64(d / relativeUnits[i])
+		if n < 1 {
+			n = 1
+		}
+	}
+	forms := locale.Units[relativeUnits[i]]
+	form := 1
+	if locale.Plural != nil {
+		form = locale.Plural(n)
+	} else if n == 1 {
+		form = 0
+	}
+	if form < 0 || form >= len(forms) {
+		form = len(forms) - 1
+	}
+	return fmt.Sprintf(format, fmt.Sprintf("%d %s", n, forms[form]))
+}
+
+// Returns count of whole calendar years between times
+func calendarYears(from, now time.Time) int64 {
+	if from.Before(now) {
+		from, now = now, from
+	}
+	now = now.In(from.Location())
+	n := from.Year() - now.Year()
+	if now.AddDate(n, 0, 0).After(from) {
+		n--
+	}
+	return int64(n)
+}
+
+// Returns index of relative unit, -1 is returned if duration isn't a relative unit
+func relativeUnitIndex(unit time.Duration) int {
+	for i, u := range relativeUnits {
+		if u == unit {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,155 @@
+package typ
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestHumanizeTime(t *testing.T) {
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	testData := []struct {
+		diff     time.Duration
+		options  []Option
+		expected string
+	}{
+		{0, nil, "just now"},
+		{-500 * time.Millisecond, nil, "just now"},
+		{-time.Second, nil, "1 second ago"},
+		{-45 * time.Second, nil, "45 seconds ago"},
+		{-90 * time.Second, nil, "1 minute ago"},
+		{-3 * time.Hour, nil, "3 hours ago"},
+		{-3*time.Hour - 59*time.Minute, nil, "3 hours ago"},
+		{2 * day, nil, "in 2 days"},
+		{-8 * day, nil, "1 week ago"},
+		{-27 * day, nil, "3 weeks ago"},
+		{-29 * day, nil, "1 month ago"},
+		{-45 * day, nil, "1 month ago"},
+		{-334 * day, nil, "11 months ago"},
+		{-364 * day, nil, "1 year ago"},
+		{-800 * day, nil, "2 years ago"},
+		{10 * time.Minute, nil, "in 10 minutes"},
+		{-10 * time.Minute, []Option{RelativeGranularity(time.Hour)}, "just now"},
+		{-90 * time.Minute, []Option{RelativeGranularity(time.Hour)}, "1 hour ago"},
+		{-3 * day, []Option{RelativeGranularity(7 * day)}, "just now"},
+		{-30 * time.Hour, []Option{RelativeThreshold(time.Hour, 48)}, "30 hours ago"},
+		{-50 * time.Hour, []Option{RelativeThreshold(time.Hour, 48)}, "2 days ago"},
+		{-20 * time.Hour, []Option{RelativeThreshold(time.Hour, 12)}, "1 day ago"},
+		{-11 * time.Hour, []Option{RelativeThreshold(time.Hour, 12)}, "11 hours ago"},
+		{-40 * time.Second, []Option{RelativeThreshold(time.Second, 30)}, "1 minute ago"},
+		{-100 * time.Second, []Option{RelativeThreshold(time.Second, 120), RelativeThreshold(time.Minute, 90)}, "100 seconds ago"},
+		{-80 * time.Minute, []Option{RelativeThreshold(time.Second, 120), RelativeThreshold(time.Minute, 90)}, "80 minutes ago"},
+	}
+	for _, v := range testData {
+		nv := HumanizeTime(now.Add(v.diff), now, v.options...)
+		if nv.V() != v.expected || nv.Err() != nil {
+			t.Errorf("HumanizeTime(%v) failed, expected %q instead of %q, error %v", v.diff, v.expected, nv.V(), nv.Err())
+		}
+	}
+	for _, v := range []struct {
+		from     time.Time
+		expected string
+	}{
+		{time.Date(1000, 1, 1, 0, 0, 0, 0, time.UTC), "1024 years ago"},
+		{time.Date(1724, 3, 10, 12, 0, 1, 0, time.UTC), "299 years ago"},
+		{time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC), "2023 years ago"},
+		{time.Date(2324, 3, 10, 12, 0, 0, 0, time.UTC), "in 300 years"},
+		{time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC), "in 7975 years"},
+	} {
+		if nv := HumanizeTime(v.from, now); nv.V() != v.expected || nv.Err() != nil {
+			t.Errorf("HumanizeTime(%v) failed, expected %q instead of %q, error %v", v.from, v.expected, nv.V(), nv.Err())
+		}
+	}
+	for _, option := range []Option{
+		RelativeGranularity(time.Millisecond),
+		RelativeThreshold(365*day, 2),
+		RelativeThreshold(time.Hour, 0),
+		RelativeLocale("unknown"),
+	} {
+		if nv := HumanizeTime(now, now, option); !errors.Is(nv.Err(), ErrInvalidArgument) {
+			t.Errorf("HumanizeTime() failed, expected error %v instead of %v", ErrInvalidArgument, nv.Err())
+		}
+	}
+}
+
+func TestRelativeLocale(t *testing.T) {
+	ru := RelativeTimeLocale{
+		Past:   "%s назад",
+		Future: "через %s",
+		Now:    "только что",
+		Units: map[time.Duration][]string{
+			time.Second:          {"секунду", "секунды", "секунд"},
+			time.Minute:          {"минуту", "минуты", "минут"},
+			time.Hour:            {"час", "часа", "часов"},
+			24 * time.Hour:       {"день", "дня", "дней"},
+			7 * 24 * time.Hour:   {"неделю", "недели", "недель"},
+			30 * 24 * time.Hour:  {"месяц", "месяца", "месяцев"},
+			365 * 24 * time.Hour: {"год", "года", "лет"},
+		},
+		Plural: func(n int64) int {
+			switch {
+			case n%10 == 1 && n%100 != 11:
+				return 0
+			case n%10 >= 2 && n%10 <= 4 && (n%100 < 10 || n%100 >= 20):
+				return 1
+			}
+			return 2
+		},
+	}
+	if err := RegisterRelativeLocale("ru", ru); err != nil {
+		t.Fatalf("RegisterRelativeLocale() failed, error %v", err)
+	}
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	for diff, expected := range map[time.Duration]string{
+		-21 * time.Hour: "21 час назад",
+		-3 * time.Hour:  "3 часа назад",
+		5 * time.Minute: "через 5 минут",
+		0:               "только что",
+	} {
+		if nv := HumanizeTime(now.Add(diff), now, RelativeLocale("ru")); nv.V() != expected {
+			t.Errorf("HumanizeTime(%v) failed, expected %q instead of %q", diff, expected, nv.V())
+		}
+	}
+	for _, l := range []RelativeTimeLocale{
+		{},
+		{Past: "%s ago", Future: "in", Units: ru.Units},
+		{Past: "%s ago", Future: "in %s"},
+	} {
+		if err := RegisterRelativeLocale("invalid", l); !errors.Is(err, ErrInvalidArgument) {
+			t.Errorf("RegisterRelativeLocale() failed, expected error %v instead of %v", ErrInvalidArgument, err)
+		}
+	}
+}
+
+func TestTimeAgo(t *testing.T) {
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	testData := []struct {
+		value    interface{}
+		expected string
+		err      error
+	}{
+		{now.Add(-3 * time.Hour), "3 hours ago", nil},
+		{"2024-03-08T12:00:00Z", "2 days ago", nil},
+		{now.Add(time.Minute).Unix(), "in 1 minute", nil},
+		{Date{2024, 3, 9}, "1 day ago", nil},
+		{"invalid", "", ErrConvert},
+		{true, "", ErrConvert},
+	}
+	for _, v := range testData {
+		nv := Of(v.value, RelativeTo(now)).TimeAgo()
+		if nv.V() != v.expected || !errors.Is(nv.Err(), v.err) {
+			t.Errorf("Of(%v).TimeAgo() failed, expected (expected == actual) %q == %q, error %v == %v",
+				v.value, v.expected, nv.V(), v.err, nv.Err(),
+			)
+		}
+	}
+	if nv := Of(time.Now().Add(-2 * time.Hour)).TimeAgo(); nv.V() != "2 hours ago" {
+		t.Errorf("Of().TimeAgo() failed, expected \"2 hours ago\" instead of %q", nv.V())
+	}
+	nt := NullTime{}
+	nt.Set(now.Add(-48 * time.Hour))
+	if nv := nt.Typ(RelativeTo(now)).TimeAgo(); nv.V() != "2 days ago" {
+		t.Errorf("NullTime.Typ().TimeAgo() failed, expected \"2 days ago\" instead of %q", nv.V())
+	}
+}
@@ -62,8 +62,15 @@ func (t *Type) toString() StringAccessor {
 		nv.P = &v
 		return nv
 	}
+	if v, ok := bigString(t.rv); ok {
+		nv.P = &v
+		return nv
+	}
 	if t.IsNumeric(true) {
 		v := NumericToString(t.rv.Interface(), *t.opts.base, *t.opts.fmtByte, *t.opts.precision)
+		if t.opts.locale != nil && localizable(t, *t.opts.base, *t.opts.fmtByte) {
+			v = t.opts.locale.format(v)
+		}
 		nv.P = &v
 		return nv
 	}
@@ -225,6 +232,9 @@ func FloatString(from float64, options ...FloatStringOption) StringAccessor {
 		return nv
 	}
 	v := strconv.FormatFloat(from, opts.fmtByte, opts.precision, opts.bitSize)
+	if opts.locale != nil && opts.fmtByte != 'b' {
+		v = opts.locale.format(v)
+	}
 	nv.P = &v
 	return nv
 }
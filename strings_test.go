@@ -211,7 +211,7 @@ func TestConcat(t *testing.T) {
 		},
 		{
 			[]interface{}{float32(MaxFloat32), "someString", int(1)},
-			"3.4028235e+38someString1",
+			"340282350000000000000000000000000000000someString1",
 			[]Option{},
 		},
 	}
@@ -396,7 +396,7 @@ func TestStringFloat(t *testing.T) {
 		},
 		{
 			float32(MaxInt16),
-			"3.2767e+04",
+			"32767",
 			true,
 		},
 	}
@@ -0,0 +1,308 @@
+package typ
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EpochUnit is a unit of numeric time values counted from Unix epoch
+type EpochUnit int
+
+const (
+	// EpochSeconds is used for numeric values in seconds
+	EpochSeconds EpochUnit = iota
+	// EpochMilliseconds is used for numeric values in milliseconds
+	EpochMilliseconds
+	// EpochMicroseconds is used for numeric values in microseconds
+	EpochMicroseconds
+	// EpochNanoseconds is used for numeric values in nanoseconds
+	EpochNanoseconds
+	// EpochAuto detects unit by magnitude of value: less than 1e11 are seconds, less than 1e14 are milliseconds,
+	// less than 1e17 are microseconds, otherwise nanoseconds
+	EpochAuto
+)
+
+var epochUnits = map[EpochUnit]float64{
+	EpochSeconds:      1e9,
+	EpochMilliseconds: 1e6,
+	EpochMicroseconds: 1e3,
+	EpochNanoseconds:  1,
+}
+
+// DefaultTimeLayouts are layouts used for conversion of string values to time.Time if layouts option isn't set
+var DefaultTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02",
+}
+
+var (
+	timeLayoutsMu sync.RWMutex
+	timeLayouts   = map[string][]string{}
+)
+
+// RegisterTimeLayouts register accepted layouts under the given name for Layouts of NullTime & NotNullTime,
+// registered layouts replace existing ones. Returns ErrInvalidArgument if name or layouts are empty
+func RegisterTimeLayouts(name string, layouts ...string) error {
+	if name == "" || len(layouts) == 0 {
+		return ErrInvalidArgument
+	}
+	timeLayoutsMu.Lock()
+	defer timeLayoutsMu.Unlock()
+	timeLayouts[name] = append([]string{}, layouts...)
+	return nil
+}
+
+// Returns layouts registered under the given name
+func lookupTimeLayouts(name string) ([]string, bool) {
+	timeLayoutsMu.RLock()
+	defer timeLayoutsMu.RUnlock()
+	layouts, ok := timeLayouts[name]
+	return layouts, ok
+}
+
+// TimeLayouts set accepted layouts for conversion of string values to time.Time, layouts are tried in order
+func TimeLayouts(layouts ...string) Option {
+	return func(t *opts) error {
+		if len(layouts) == 0 {
+			return ErrInvalidArgument
+		}
+		t.layouts = layouts
+		return nil
+	}
+}
+
+// Location set location of time.Time values.
+// Strings without time zone are parsed in location, all converted values are returned in location.
+// Values are converted in UTC location by default, except time.Time values which are returned as is
+func Location(loc *time.Location) Option {
+	return func(t *opts) error {
+		if loc == nil {
+			return ErrInvalidArgument
+		}
+		t.location = loc
+		return nil
+	}
+}
+
+// Epoch set unit of numeric values for conversion to time.Time. Seconds are used by default
+func Epoch(unit EpochUnit) Option {
+	return func(t *opts) error {
+		if unit < EpochSeconds || unit > EpochAuto {
+			return ErrInvalidArgument
+		}
+		t.epoch = unit
+		return nil
+	}
+}
+
+// Time convert interface value to time.Time.
+// Strings are parsed by TimeLayouts, numbers are counted from Unix epoch by Epoch unit, Date is midnight in Location.
+// Returns value if type can safely converted, otherwise error & default value in result values
+func (t *Type) Time(defaultValue ...time.Time) TimeAccessor {
+	nv := &NullTime{}
+	if nv.Error = t.err; t.err != nil {
+		return nv
+	}
+	nv = t.toTime()
+	defaultTime(nv, defaultValue...)
+	return nv
+}
+
+// Convert interface value to time.Time.
+// Returns error if type can't safely converted
+func (t *Type) toTime() *NullTime {
+	nv := &NullTime{}
+	if nt, ok := t.fromNumber(); ok {
+		return nt.toTime()
+	}
+	if !t.rv.IsValid() {
+		nv.Error = t.newConversionError(reflect.Struct, nil)
+		return nv
+	}
+	var (
+		v   time.Time
+		err error
+	)
+	switch {
+	case t.rv.Type() == reflect.TypeOf(v):
+		v = t.rv.Interface().(time.Time)
+		if t.opts.location != nil {
+			v = v.In(t.opts.location)
+		}
+	case t.rv.Type() == dateType:
+		loc := time.UTC
+		if t.opts.location != nil {
+			loc = t.opts.location
+		}
+		v = t.rv.Interface().(Date).In(loc)
+	case t.IsString(true):
+		v, err = stringTime(t.rv.String(), t.opts)
+	case t.IsInt(true):
+		v, err = intTime(t.rv.Int(), t.opts)
+	case t.IsUint(true):
+		if t.rv.Uint() > math.MaxInt64 {
+			err = ErrConvert
+			break
+		}
+		v, err = intTime(int64(t.rv.Uint()), t.opts)
+	case t.IsFloat(true):
+		v, err = floatTime(t.rv.Float(), t.opts)
+	default:
+		err = ErrConvert
+	}
+	if err != nil {
+		nv.Error = t.newConversionError(reflect.Struct, err)
+		return nv
+	}
+	nv.P = &v
+	return nv
+}
+
+// StringTime convert value from string to time.Time.
+// Strings are parsed by TimeLayouts, numeric strings are counted from Unix epoch by Epoch unit.
+// Returns value if type can safely converted, otherwise error in result values
+func StringTime(from string, options ...Option) TimeAccessor {
+	return nativeTime(from, options, func(o opts) (time.Time, error) {
+		return stringTime(from, o)
+	})
+}
+
+// IntTime convert value from int64 counted from Unix epoch by Epoch unit to time.Time.
+// Returns value if type can safely converted, otherwise error in result values
+func IntTime(from int64, options ...Option) TimeAccessor {
+	return nativeTime(from, options, func(o opts) (time.Time, error) {
+		return intTime(from, o)
+	})
+}
+
+// FloatTime convert value from float64 counted from Unix epoch by Epoch unit to time.Time.
+// Fractional part is used as fraction of unit. Returns value if type can safely converted, otherwise error in result values
+func FloatTime(from float64, options ...Option) TimeAccessor {
+	return nativeTime(from, options, func(o opts) (time.Time, error) {
+		return floatTime(from, o)
+	})
+}
+
+// Convert native value to time.Time by fn with given options
+func nativeTime(from interface{}, options []Option, fn func(o opts) (time.Time, error)) TimeAccessor {
+	nv := &NullTime{}
+	var o opts
+	for _, v := range options {
+		if nv.Error = v(&o); nv.Error != nil {
+			return nv
+		}
+	}
+	v, err := fn(o)
+	if err != nil {
+		nv.Error = newConversionError(from, reflect.Struct, err)
+		return nv
+	}
+	nv.P = &v
+	return nv
+}
+
+// Parse string by layouts of options, numeric strings are counted from Unix epoch
+func stringTime(from string, o opts) (time.Time, error) {
+	layouts := o.layouts
+	if layouts == nil {
+		layouts = DefaultTimeLayouts
+	}
+	loc := o.location
+	if loc == nil {
+		loc = time.UTC
+	}
+	var err error
+	from = strings.TrimSpace(from)
+	for _, layout := range layouts {
+		var v time.Time
+		if v, err = time.ParseInLocation(layout, from, loc); err == nil {
+			if o.location != nil {
+				v = v.In(o.location)
+			}
+			return v, nil
+		}
+	}
+	if i, intErr := strconv.ParseInt(from, 10, 64); intErr == nil {
+		return intTime(i, o)
+	}
+	if f, floatErr := strconv.ParseFloat(from, 64); floatErr == nil {
+		return floatTime(f, o)
+	}
+	return time.Time{}, err
+}
+
+// Convert int value counted from Unix epoch to time.Time
+func intTime(from int64, o opts) (time.Time, error) {
+	unit := epochUnit(float64(from), o.epoch)
+	var v time.Time
+	switch unit {
+	case EpochSeconds:
+		v = time.Unix(from, 0)
+	case EpochMilliseconds:
+		v = time.UnixMilli(from)
+	case EpochMicroseconds:
+		v = time.UnixMicro(from)
+	default:
+		v = time.Unix(0, from)
+	}
+	return timeIn(v, o), nil
+}
+
+// Convert float value counted from Unix epoch to time.Time, fractional part is used as fraction of unit
+func floatTime(from float64, o opts) (time.Time, error) {
+	if math.IsNaN(from) || math.IsInf(from, 0) {
+		return time.Time{}, ErrConvert
+	}
+	unit := epochUnit(from, o.epoch)
+	whole, frac := math.Modf(from)
+	if whole >= math.MaxInt64 || whole < math.MinInt64 {
+		return time.Time{}, ErrConvert
+	}
+	v, err := intTime(int64(whole), opts{location: o.location, epoch: unit})
+	return v.Add(time.Duration(math.Round(frac * epochUnits[unit]))), err
+}
+
+// Returns unit of value, unit is detected by magnitude of value if unit is EpochAuto
+func epochUnit(from float64, unit EpochUnit) EpochUnit {
+	if unit != EpochAuto {
+		return unit
+	}
+	switch from = math.Abs(from); {
+	case from < 1e11:
+		return EpochSeconds
+	case from < 1e14:
+		return EpochMilliseconds
+	case from < 1e17:
+		return EpochMicroseconds
+	}
+	return EpochNanoseconds
+}
+
+// Returns time in location of options, UTC is used by default
+func timeIn(v time.Time, o opts) time.Time {
+	if o.location != nil {
+		return v.In(o.location)
+	}
+	return v.UTC()
+}
+
+// Set default time value if time isn't valid
+func defaultTime(nv *NullTime, defaultValue ...time.Time) bool {
+	if len(defaultValue) > 1 {
+		nv.Error = ErrDefaultValue
+		return true
+	}
+	if !nv.Valid() && len(defaultValue) > 0 {
+		v := defaultValue[0]
+		nv.P = &v
+		return true
+	}
+	return false
+}
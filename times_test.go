@@ -0,0 +1,108 @@
+package typ
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestTime(t *testing.T) {
+	moscow := time.FixedZone("MSK", 3*60*60)
+	base := time.Date(2023, 11, 14, 22, 13, 20, 0, time.UTC)
+	testData := []struct {
+		value    interface{}
+		options  []Option
+		expected time.Time
+		err      error
+	}{
+		{"2023-11-14T22:13:20Z", nil, base, nil},
+		{"2023-11-14T22:13:20.5Z", nil, base.Add(500 * time.Millisecond), nil},
+		{"2023-11-15T01:13:20+03:00", nil, base, nil},
+		{" 2023-11-14 22:13:20 ", nil, base, nil},
+		{"2023-11-14", nil, time.Date(2023, 11, 14, 0, 0, 0, 0, time.UTC), nil},
+		{"2023-11-15 01:13:20", []Option{Location(moscow)}, base, nil},
+		{"14.11.2023 22:13", []Option{TimeLayouts("02.01.2006 15:04")}, base.Add(-20 * time.Second), nil},
+		{"14.11.2023", []Option{TimeLayouts("02.01.2006 15:04")}, time.Time{}, ErrConvert},
+		{"1700000000", nil, base, nil},
+		{"1700000000000", []Option{Epoch(EpochAuto)}, base, nil},
+		{"1700000000.25", nil, base.Add(250 * time.Millisecond), nil},
+		{"not a time", nil, time.Time{}, ErrConvert},
+		{1700000000, nil, base, nil},
+		{int32(1700000000), nil, base, nil},
+		{uint64(1700000000), nil, base, nil},
+		{uint64(math.MaxUint64), nil, time.Time{}, ErrConvert},
+		{1700000000000, []Option{Epoch(EpochMilliseconds)}, base, nil},
+		{1700000000000000, []Option{Epoch(EpochMicroseconds)}, base, nil},
+		{1700000000000000000, []Option{Epoch(EpochNanoseconds)}, base, nil},
+		{1700000000, []Option{Epoch(EpochAuto)}, base, nil},
+		{1700000000000, []Option{Epoch(EpochAuto)}, base, nil},
+		{1700000000000000, []Option{Epoch(EpochAuto)}, base, nil},
+		{1700000000000000000, []Option{Epoch(EpochAuto)}, base, nil},
+		{1700000000.5, nil, base.Add(500 * time.Millisecond), nil},
+		{1700000000000.5, []Option{Epoch(EpochMilliseconds)}, base.Add(500 * time.Microsecond), nil},
+		{-1.5, nil, time.Unix(-2, 500000000).UTC(), nil},
+		{math.NaN(), nil, time.Time{}, ErrConvert},
+		{math.Inf(1), nil, time.Time{}, ErrConvert},
+		{1e300, nil, time.Time{}, ErrConvert},
+		{json.Number("1700000000"), nil, base, nil},
+		{base, nil, base, nil},
+		{base, []Option{Location(moscow)}, base, nil},
+		{true, nil, time.Time{}, ErrConvert},
+		{nil, nil, time.Time{}, ErrConvert},
+		{struct{}{}, nil, time.Time{}, ErrConvert},
+		{1, []Option{Location(nil)}, time.Time{}, ErrInvalidArgument},
+		{1, []Option{Epoch(EpochUnit(99))}, time.Time{}, ErrInvalidArgument},
+		{"2023-11-14", []Option{TimeLayouts()}, time.Time{}, ErrInvalidArgument},
+	}
+	for _, v := range testData {
+		nv := Of(v.value, v.options...).Time()
+		if !nv.V().Equal(v.expected) || !errors.Is(nv.Err(), v.err) {
+			t.Errorf("Of(%v).Time() failed, expected (expected == actual) %v == %v, error %v == %v",
+				v.value, v.expected, nv.V(), v.err, nv.Err(),
+			)
+		}
+	}
+	if nv := Of(1700000000, Location(moscow)).Time(); nv.V().Location() != moscow {
+		t.Errorf("Of(1700000000).Time() failed, expected location %v instead of %v", moscow, nv.V().Location())
+	}
+	if nv := Of(1700000000).Time(); nv.V().Location() != time.UTC {
+		t.Errorf("Of(1700000000).Time() failed, expected location %v instead of %v", time.UTC, nv.V().Location())
+	}
+	if nv := Of("invalid").Time(base); !nv.V().Equal(base) || !errors.Is(nv.Err(), ErrConvert) {
+		t.Errorf("Of(invalid).Time() failed, expected default value %v instead of %v, error %v", base, nv.V(), nv.Err())
+	}
+	if nv := Of("invalid").Time(base, base); !errors.Is(nv.Err(), ErrDefaultValue) {
+		t.Errorf("Of(invalid).Time() failed, expected error %v instead of %v", ErrDefaultValue, nv.Err())
+	}
+	var ce *ConversionError
+	if nv := Of("invalid").Time(); !errors.As(nv.Err(), &ce) || ce.Reason != ReasonSyntax {
+		t.Errorf("Of(invalid).Time() failed, expected syntax reason instead of %v", nv.Err())
+	}
+}
+
+func TestNativeTime(t *testing.T) {
+	base := time.Date(2023, 11, 14, 22, 13, 20, 0, time.UTC)
+	if nv := StringTime("2023-11-14T22:13:20Z"); !nv.V().Equal(base) || nv.Err() != nil {
+		t.Errorf("StringTime() failed, expected %v instead of %v, error %v", base, nv.V(), nv.Err())
+	}
+	if nv := StringTime("1700000000000", Epoch(EpochMilliseconds)); !nv.V().Equal(base) || nv.Err() != nil {
+		t.Errorf("StringTime() failed, expected %v instead of %v, error %v", base, nv.V(), nv.Err())
+	}
+	if nv := StringTime("invalid"); nv.Present() || !errors.Is(nv.Err(), ErrConvert) {
+		t.Errorf("StringTime() failed, expected error %v instead of %v", ErrConvert, nv.Err())
+	}
+	if nv := IntTime(1700000000000000, Epoch(EpochAuto)); !nv.V().Equal(base) || nv.Err() != nil {
+		t.Errorf("IntTime() failed, expected %v instead of %v, error %v", base, nv.V(), nv.Err())
+	}
+	if nv := IntTime(1, Epoch(EpochUnit(-1))); !errors.Is(nv.Err(), ErrInvalidArgument) {
+		t.Errorf("IntTime() failed, expected error %v instead of %v", ErrInvalidArgument, nv.Err())
+	}
+	if nv := FloatTime(1700000000.125); !nv.V().Equal(base.Add(125*time.Millisecond)) || nv.Err() != nil {
+		t.Errorf("FloatTime() failed, expected %v instead of %v, error %v", base, nv.V(), nv.Err())
+	}
+	if nv := FloatTime(math.NaN()); !errors.Is(nv.Err(), ErrConvert) {
+		t.Errorf("FloatTime() failed, expected error %v instead of %v", ErrConvert, nv.Err())
+	}
+}
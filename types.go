@@ -3,6 +3,7 @@ package typ
 import (
 	"errors"
 	"reflect"
+	"time"
 	"unsafe"
 )
 
@@ -27,6 +28,7 @@ type (
 		fmtByte      byte
 		precision    int
 		bitSize      int
+		locale       *NumberLocale
 	}
 	complexStrOpts struct {
 		defaultValue *string
@@ -48,6 +50,24 @@ type opts struct {
 	fmtByte                   *byte
 	base, precision           *int
 	suffix, prefix, delimiter *string
+	rounding                  RoundingMode
+	overflow                  OverflowMode
+	layouts                   []string
+	location                  *time.Location
+	epoch                     EpochUnit
+	unit                      time.Duration
+	scale                     *int
+	trimSpace, integral       bool
+	separator                 rune
+	locale                    *NumberLocale
+	boolWords                 []BoolVocabulary
+	strictBool                bool
+	precisionSet              bool
+	compact                   CompactScale
+	relative                  *RelativeTimeLocale
+	granularity               time.Duration
+	thresholds                map[time.Duration]int64
+	now                       *time.Time
 }
 
 // IntStringDefault set default string value for int conversion to string.
@@ -162,7 +182,7 @@ func ComplexStringDefault(value string) ComplexStringOption {
 // necessary such that ParseFloat will return f exactly.
 func Precision(value int) Option {
 	return func(t *opts) error {
-		t.precision = &value
+		t.precision, t.precisionSet = &value, true
 		return nil
 	}
 }
@@ -230,6 +250,10 @@ type Type struct {
 	kind reflect.Kind
 	opts opts
 	err  error
+	// path is index keys of value retrieved by Get, it's formatted only for conversion errors
+	path []interface{}
+	// origin is big number or Decimal which rv was converted from, it's reported by conversion errors
+	origin reflect.Value
 }
 
 // Convert "value" to any convertible primitive types
@@ -265,6 +289,22 @@ func (t *Type) to(typeTo reflect.Kind) InterfaceAccessor {
 	return nv
 }
 
+// Returns conversion error of "value" or value which it was converted from to kind with path of value retrieved by Get
+func (t *Type) newConversionError(typeTo reflect.Kind, err error) *ConversionError {
+	var value interface{}
+	switch {
+	case t.origin.IsValid() && t.origin.Type() != decimalType:
+		value = bigPointer(t.origin)
+	case t.origin.IsValid():
+		value = t.origin.Interface()
+	case t.rv.IsValid() && t.rv.CanInterface():
+		value = t.rv.Interface()
+	}
+	ce := newConversionError(value, typeTo, err)
+	ce.Path = keyPath(t.path)
+	return ce
+}
+
 // Empty determine whether a variable is zero
 func (t *Type) Empty() BoolAccessor {
 	nv := &NullBool{}
@@ -315,7 +355,7 @@ func (t *Type) Empty() BoolAccessor {
 // Primitives type is: int, uint, float, complex, bool
 func (t *Type) Equals(value interface{}) BoolAccessor {
 	if vp := Of(value).to(t.rv.Kind()); vp.Valid() {
-		value = vp.V()
+		value = reflect.ValueOf(vp.V()).Convert(t.rv.Type()).Interface()
 	}
 	return t.Identical(value)
 }
@@ -381,8 +421,8 @@ func NewType(value interface{}, err error, options ...Option) *Type {
 	nt := &Type{err: err}
 	switch v := value.(type) {
 	case *Type:
-		nt.rv, nt.kind = v.rv, v.kind
-		nt.opts.fmtByte, nt.opts.base, nt.opts.precision = v.opts.fmtByte, v.opts.base, v.opts.precision
+		nt.rv, nt.kind, nt.path, nt.origin = v.rv, v.kind, v.path, v.origin
+		nt.opts = v.opts
 		if v.err != nil && err == nil {
 			nt.err = v.err
 		}
@@ -403,23 +443,28 @@ func NewType(value interface{}, err error, options ...Option) *Type {
 				break next
 			}
 		}
-		if len(options) > 0 {
-			for _, v := range options {
-				if optErr := v(&nt.opts); optErr != nil {
-					nt.err = optErr
-					break
-				}
+		nt.setOptions(options...)
+		return nt
+	}
+}
+
+// Apply options to current type, options which are not set filled by default values
+func (t *Type) setOptions(options ...Option) {
+	if len(options) > 0 {
+		for _, v := range options {
+			if optErr := v(&t.opts); optErr != nil {
+				t.err = optErr
+				break
 			}
 		}
-		if nt.opts.base == nil {
-			nt.opts.base = &dBase
-		}
-		if nt.opts.fmtByte == nil {
-			nt.opts.fmtByte = &dFmtByte
-		}
-		if nt.opts.precision == nil {
-			nt.opts.precision = &dPrecision
-		}
-		return nt
+	}
+	if t.opts.base == nil {
+		t.opts.base = &dBase
+	}
+	if t.opts.fmtByte == nil {
+		t.opts.fmtByte = &dFmtByte
+	}
+	if t.opts.precision == nil {
+		t.opts.precision = &dPrecision
 	}
 }
@@ -35,6 +35,13 @@ func init() {
 	nullReflectTypes = append(nullReflectTypes, nullStringReflectTypes...)
 	nullReflectTypes = append(nullReflectTypes, nullTimeReflectTypes...)
 	nullReflectTypes = append(nullReflectTypes, nullUintReflectTypes...)
+	nullReflectTypes = append(nullReflectTypes, nullDurationReflectTypes...)
+	nullReflectTypes = append(nullReflectTypes, nullDateReflectTypes...)
+	nullReflectTypes = append(nullReflectTypes, nullClockReflectTypes...)
+	nullReflectTypes = append(nullReflectTypes, nullBigIntReflectTypes...)
+	nullReflectTypes = append(nullReflectTypes, nullBigFloatReflectTypes...)
+	nullReflectTypes = append(nullReflectTypes, nullBigRatReflectTypes...)
+	nullReflectTypes = append(nullReflectTypes, nullDecimalReflectTypes...)
 	dm := map[string]interface{}{"key": "Value"}
 	ds := []interface{}{1, 2, 3}
 	matrixSuite.Register(reflect.TypeOf(JSONToken{}), []dataItem{
@@ -149,6 +156,20 @@ func TestEquals(t *testing.T) {
 			true,
 			nil,
 		},
+		{
+			1,
+			[]interface{}{1.0},
+			true,
+			true,
+			nil,
+		},
+		{
+			int8(2),
+			[]interface{}{uint64(2)},
+			true,
+			true,
+			nil,
+		},
 	}
 	var (
 		val           interface{}
@@ -597,7 +618,7 @@ func TestSetFloatPrecision(t *testing.T) {
 
 func TestSetGetFloatFmt(t *testing.T) {
 	typ := Of(nil)
-	if typ.OptionFmtByte() != 'e' {
+	if typ.OptionFmtByte() != 'f' {
 		t.Error("Of(nil).OptionFmtByte() failed, default float fmt is changed")
 	}
 	typ = Of(nil, FmtByte('g'))
@@ -606,8 +627,8 @@ func TestSetGetFloatFmt(t *testing.T) {
 	}
 	// Error
 	typ = Of(nil, FmtByte('z'))
-	if typ.OptionFmtByte() != 'e' {
-		t.Error("Of(nil, FmtByte('z')).OptionFmtByte() failed, expects `e`")
+	if typ.OptionFmtByte() != 'f' {
+		t.Error("Of(nil, FmtByte('z')).OptionFmtByte() failed, expects `f`")
 	}
 	if typ.Error() != ErrFmtByteInvalid {
 		t.Errorf("Of(nil, FmtByte('z')).Error() failed, expects %v", ErrFmtByteInvalid)
@@ -712,6 +733,34 @@ func testGetNullIfaceValue(nv interface{}) testNullSuite {
 		return testNullSuite{value: v.V(), nkind: reflect.Struct, valid: v.Valid(), present: v.Present(), err: v.Error}
 	case NotNullTime:
 		return testNullSuite{value: v.V(), nkind: reflect.Struct, valid: v.Valid(), present: v.Present(), err: v.Error}
+	case NullDuration:
+		return testNullSuite{value: v.V(), nkind: reflect.Int64, valid: v.Valid(), present: v.Present(), err: v.Error}
+	case NotNullDuration:
+		return testNullSuite{value: v.V(), nkind: reflect.Int64, valid: v.Valid(), present: v.Present(), err: v.Error}
+	case NullDate:
+		return testNullSuite{value: v.V(), nkind: reflect.Struct, valid: v.Valid(), present: v.Present(), err: v.Error}
+	case NotNullDate:
+		return testNullSuite{value: v.V(), nkind: reflect.Struct, valid: v.Valid(), present: v.Present(), err: v.Error}
+	case NullClock:
+		return testNullSuite{value: v.V(), nkind: reflect.Struct, valid: v.Valid(), present: v.Present(), err: v.Error}
+	case NotNullClock:
+		return testNullSuite{value: v.V(), nkind: reflect.Struct, valid: v.Valid(), present: v.Present(), err: v.Error}
+	case NullBigInt:
+		return testNullSuite{value: v.V(), nkind: reflect.Ptr, valid: v.Valid(), present: v.Present(), err: v.Error}
+	case NotNullBigInt:
+		return testNullSuite{value: v.V(), nkind: reflect.Ptr, valid: v.Valid(), present: v.Present(), err: v.Error}
+	case NullBigFloat:
+		return testNullSuite{value: v.V(), nkind: reflect.Ptr, valid: v.Valid(), present: v.Present(), err: v.Error}
+	case NotNullBigFloat:
+		return testNullSuite{value: v.V(), nkind: reflect.Ptr, valid: v.Valid(), present: v.Present(), err: v.Error}
+	case NullBigRat:
+		return testNullSuite{value: v.V(), nkind: reflect.Ptr, valid: v.Valid(), present: v.Present(), err: v.Error}
+	case NotNullBigRat:
+		return testNullSuite{value: v.V(), nkind: reflect.Ptr, valid: v.Valid(), present: v.Present(), err: v.Error}
+	case NullDecimal:
+		return testNullSuite{value: v.V(), nkind: reflect.Struct, valid: v.Valid(), present: v.Present(), err: v.Error}
+	case NotNullDecimal:
+		return testNullSuite{value: v.V(), nkind: reflect.Struct, valid: v.Valid(), present: v.Present(), err: v.Error}
 	case NullInterface:
 		return testNullSuite{value: v.V(), nkind: reflect.ValueOf(v.V()).Kind(), valid: v.Valid(), present: v.Present(), err: v.Error}
 	case NotNullInterface:
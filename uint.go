@@ -10,13 +10,25 @@ import (
 func (t *Type) toUint(typeTo reflect.Kind) Uint64Accessor {
 	nv := &NullUint64{}
 	if !t.rv.IsValid() || !isUint(typeTo) {
-		nv.Error = ErrConvert
+		nv.Error = t.newConversionError(typeTo, nil)
 		return nv
 	}
+	if nt, ok := t.fromNumber(); ok {
+		return nt.toUint(typeTo)
+	}
+	if t.opts.lossy() {
+		if bits, lost, ok := t.toLossy(typeTo); ok {
+			v := bits
+			nv.P, nv.Lost = &v, lost
+			return nv
+		}
+	}
 	switch {
 	case t.IsString(true):
-		value, err := strconv.ParseUint(t.rv.String(), 0, bitSizeMap[typeTo])
-		nv.Error = err
+		value, err := t.parseUint(bitSizeMap[typeTo])
+		if err != nil {
+			nv.Error = t.newConversionError(typeTo, err)
+		}
 		v := value
 		nv.P = &v
 		return nv
@@ -25,7 +37,7 @@ func (t *Type) toUint(typeTo reflect.Kind) Uint64Accessor {
 		v := uintValue
 		nv.P = &v
 		if !isSafeUint(uintValue, bitSizeMap[typeTo]) {
-			nv.Error = ErrConvert
+			nv.Error = t.newConversionError(typeTo, nil)
 		}
 		return nv
 	case t.IsInt(true):
@@ -33,7 +45,7 @@ func (t *Type) toUint(typeTo reflect.Kind) Uint64Accessor {
 		v := uint64(intValue)
 		nv.P = &v
 		if !isSafeIntToUint(intValue, bitSizeMap[typeTo]) {
-			nv.Error = ErrConvert
+			nv.Error = t.newConversionError(typeTo, nil)
 		}
 		return nv
 	case t.IsFloat(true):
@@ -41,7 +53,7 @@ func (t *Type) toUint(typeTo reflect.Kind) Uint64Accessor {
 		v := uint64(floatValue)
 		nv.P = &v
 		if !isSafeFloatToUint(floatValue, bitSizeMap[t.Kind()], bitSizeMap[typeTo]) {
-			nv.Error = ErrConvert
+			nv.Error = t.newConversionError(typeTo, nil)
 		}
 		return nv
 	case t.IsComplex(true):
@@ -49,7 +61,7 @@ func (t *Type) toUint(typeTo reflect.Kind) Uint64Accessor {
 		v := uint64(real(complexValue))
 		nv.P = &v
 		if !isSafeComplexToUint(complexValue, bitSizeMap[t.Kind()], bitSizeMap[typeTo]) {
-			nv.Error = ErrConvert
+			nv.Error = t.newConversionError(typeTo, nil)
 		}
 		return nv
 	case t.IsBool(true):
@@ -62,7 +74,7 @@ func (t *Type) toUint(typeTo reflect.Kind) Uint64Accessor {
 		}
 		return nv
 	}
-	nv.Error = ErrConvert
+	nv.Error = t.newConversionError(typeTo, nil)
 	return nv
 }
 
@@ -74,7 +86,7 @@ func (t *Type) Uint(defaultValue ...uint) UintAccessor {
 		return nv
 	}
 	valueTo := t.toUint(reflect.Uint)
-	nv = &NullUint{UintCommon{Error: valueTo.Err()}}
+	nv = &NullUint{UintCommon{Error: valueTo.Err(), Lost: valueTo.PrecisionLost()}}
 	if defaultUint(nv, defaultValue...) {
 		return nv
 	}
@@ -91,7 +103,7 @@ func (t *Type) Uint8(defaultValue ...uint8) Uint8Accessor {
 		return nv
 	}
 	valueTo := t.toUint(reflect.Uint8)
-	nv = &NullUint8{Uint8Common{Error: valueTo.Err()}}
+	nv = &NullUint8{Uint8Common{Error: valueTo.Err(), Lost: valueTo.PrecisionLost()}}
 	if defaultUint8(nv, defaultValue...) {
 		return nv
 	}
@@ -108,7 +120,7 @@ func (t *Type) Uint16(defaultValue ...uint16) Uint16Accessor {
 		return nv
 	}
 	valueTo := t.toUint(reflect.Uint16)
-	nv = &NullUint16{Uint16Common{Error: valueTo.Err()}}
+	nv = &NullUint16{Uint16Common{Error: valueTo.Err(), Lost: valueTo.PrecisionLost()}}
 	if defaultUint16(nv, defaultValue...) {
 		return nv
 	}
@@ -125,7 +137,7 @@ func (t *Type) Uint32(defaultValue ...uint32) Uint32Accessor {
 		return nv
 	}
 	valueTo := t.toUint(reflect.Uint32)
-	nv = &NullUint32{Uint32Common{Error: valueTo.Err()}}
+	nv = &NullUint32{Uint32Common{Error: valueTo.Err(), Lost: valueTo.PrecisionLost()}}
 	if defaultUint32(nv, defaultValue...) {
 		return nv
 	}
@@ -142,7 +154,7 @@ func (t *Type) Uint64(defaultValue ...uint64) Uint64Accessor {
 		return nv
 	}
 	valueTo := t.toUint(reflect.Uint64)
-	nv = &NullUint64{Uint64Common{Error: valueTo.Err()}}
+	nv = &NullUint64{Uint64Common{Error: valueTo.Err(), Lost: valueTo.PrecisionLost()}}
 	if defaultUint64(nv, defaultValue...) {
 		return nv
 	}
@@ -156,7 +168,7 @@ func (t *Type) Uint64(defaultValue ...uint64) Uint64Accessor {
 func UintInt64(from uint64, defaultValue ...int64) Int64Accessor {
 	nv := &NullInt64{}
 	if safe := isSafeUintToInt(from, 64); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Int64, nil)
 		if defaultInt64(nv, defaultValue...) {
 			return nv
 		}
@@ -171,7 +183,7 @@ func UintInt64(from uint64, defaultValue ...int64) Int64Accessor {
 func UintInt32(from uint64, defaultValue ...int32) Int32Accessor {
 	nv := &NullInt32{}
 	if safe := isSafeUintToInt(from, 32); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Int32, nil)
 		if defaultInt32(nv, defaultValue...) {
 			return nv
 		}
@@ -186,7 +198,7 @@ func UintInt32(from uint64, defaultValue ...int32) Int32Accessor {
 func UintInt16(from uint64, defaultValue ...int16) Int16Accessor {
 	nv := &NullInt16{}
 	if safe := isSafeUintToInt(from, 16); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Int16, nil)
 		if defaultInt16(nv, defaultValue...) {
 			return nv
 		}
@@ -201,7 +213,7 @@ func UintInt16(from uint64, defaultValue ...int16) Int16Accessor {
 func UintInt8(from uint64, defaultValue ...int8) Int8Accessor {
 	nv := &NullInt8{}
 	if safe := isSafeUintToInt(from, 8); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Int8, nil)
 		if defaultInt8(nv, defaultValue...) {
 			return nv
 		}
@@ -216,7 +228,7 @@ func UintInt8(from uint64, defaultValue ...int8) Int8Accessor {
 func Uint32(from uint64, defaultValue ...uint32) Uint32Accessor {
 	nv := &NullUint32{}
 	if safe := isSafeUint(from, 32); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Uint32, nil)
 		if defaultUint32(nv, defaultValue...) {
 			return nv
 		}
@@ -231,7 +243,7 @@ func Uint32(from uint64, defaultValue ...uint32) Uint32Accessor {
 func Uint16(from uint64, defaultValue ...uint16) Uint16Accessor {
 	nv := &NullUint16{}
 	if safe := isSafeUint(from, 16); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Uint16, nil)
 		if defaultUint16(nv, defaultValue...) {
 			return nv
 		}
@@ -246,7 +258,7 @@ func Uint16(from uint64, defaultValue ...uint16) Uint16Accessor {
 func Uint8(from uint64, defaultValue ...uint8) Uint8Accessor {
 	nv := &NullUint8{}
 	if safe := isSafeUint(from, 8); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Uint8, nil)
 		if defaultUint8(nv, defaultValue...) {
 			return nv
 		}
@@ -261,7 +273,7 @@ func Uint8(from uint64, defaultValue ...uint8) Uint8Accessor {
 func Float32Uint(from float32, defaultValue ...uint) UintAccessor {
 	nv := &NullUint{}
 	if safe := isSafeFloatToUint(float64(from), 32, 64); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Uint, nil)
 		if defaultUint(nv, defaultValue...) {
 			return nv
 		}
@@ -276,7 +288,7 @@ func Float32Uint(from float32, defaultValue ...uint) UintAccessor {
 func Float32Uint64(from float32, defaultValue ...uint64) Uint64Accessor {
 	nv := &NullUint64{}
 	if safe := isSafeFloatToUint(float64(from), 32, 64); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Uint64, nil)
 		if defaultUint64(nv, defaultValue...) {
 			return nv
 		}
@@ -291,7 +303,7 @@ func Float32Uint64(from float32, defaultValue ...uint64) Uint64Accessor {
 func Float32Uint32(from float32, defaultValue ...uint32) Uint32Accessor {
 	nv := &NullUint32{}
 	if safe := isSafeFloatToUint(float64(from), 32, 32); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Uint32, nil)
 		if defaultUint32(nv, defaultValue...) {
 			return nv
 		}
@@ -306,7 +318,7 @@ func Float32Uint32(from float32, defaultValue ...uint32) Uint32Accessor {
 func Float32Uint16(from float32, defaultValue ...uint16) Uint16Accessor {
 	nv := &NullUint16{}
 	if safe := isSafeFloatToUint(float64(from), 32, 16); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Uint16, nil)
 		if defaultUint16(nv, defaultValue...) {
 			return nv
 		}
@@ -321,7 +333,7 @@ func Float32Uint16(from float32, defaultValue ...uint16) Uint16Accessor {
 func Float32Uint8(from float32, defaultValue ...uint8) Uint8Accessor {
 	nv := &NullUint8{}
 	if safe := isSafeFloatToUint(float64(from), 32, 8); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Uint8, nil)
 		if defaultUint8(nv, defaultValue...) {
 			return nv
 		}
@@ -336,7 +348,7 @@ func Float32Uint8(from float32, defaultValue ...uint8) Uint8Accessor {
 func FloatUint(from float64, defaultValue ...uint) UintAccessor {
 	nv := &NullUint{}
 	if safe := isSafeFloatToUint(float64(from), 64, 64); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Uint, nil)
 		if defaultUint(nv, defaultValue...) {
 			return nv
 		}
@@ -351,7 +363,7 @@ func FloatUint(from float64, defaultValue ...uint) UintAccessor {
 func FloatUint64(from float64, defaultValue ...uint64) Uint64Accessor {
 	nv := &NullUint64{}
 	if safe := isSafeFloatToUint(float64(from), 64, 64); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Uint64, nil)
 		if defaultUint64(nv, defaultValue...) {
 			return nv
 		}
@@ -366,7 +378,7 @@ func FloatUint64(from float64, defaultValue ...uint64) Uint64Accessor {
 func FloatUint32(from float64, defaultValue ...uint32) Uint32Accessor {
 	nv := &NullUint32{}
 	if safe := isSafeFloatToUint(float64(from), 64, 32); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Uint32, nil)
 		if defaultUint32(nv, defaultValue...) {
 			return nv
 		}
@@ -381,7 +393,7 @@ func FloatUint32(from float64, defaultValue ...uint32) Uint32Accessor {
 func FloatUint16(from float64, defaultValue ...uint16) Uint16Accessor {
 	nv := &NullUint16{}
 	if safe := isSafeFloatToUint(float64(from), 64, 16); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Uint16, nil)
 		if defaultUint16(nv, defaultValue...) {
 			return nv
 		}
@@ -396,7 +408,7 @@ func FloatUint16(from float64, defaultValue ...uint16) Uint16Accessor {
 func FloatUint8(from float64, defaultValue ...uint8) Uint8Accessor {
 	nv := &NullUint8{}
 	if safe := isSafeFloatToUint(float64(from), 64, 8); !safe {
-		nv.Error = ErrConvert
+		nv.Error = newConversionError(from, reflect.Uint8, nil)
 		if defaultUint8(nv, defaultValue...) {
 			return nv
 		}
@@ -588,7 +600,9 @@ func ComplexUint8(from complex128, defaultValue ...uint8) Uint8Accessor {
 func StringUint(from string, defaultValue ...uint) UintAccessor {
 	nv := &NullUint{}
 	pv, err := strconv.ParseUint(from, 0, 64)
-	nv.Error = err
+	if err != nil {
+		nv.Error = newConversionError(from, reflect.Uint, err)
+	}
 	if defaultUint(nv, defaultValue...) {
 		return nv
 	}
@@ -602,7 +616,9 @@ func StringUint(from string, defaultValue ...uint) UintAccessor {
 func StringUint64(from string, defaultValue ...uint64) Uint64Accessor {
 	nv := &NullUint64{}
 	pv, err := strconv.ParseUint(from, 0, 64)
-	nv.Error = err
+	if err != nil {
+		nv.Error = newConversionError(from, reflect.Uint64, err)
+	}
 	if defaultUint64(nv, defaultValue...) {
 		v := defaultValue[0]
 		nv.P = &v
@@ -618,7 +634,9 @@ func StringUint64(from string, defaultValue ...uint64) Uint64Accessor {
 func StringUint32(from string, defaultValue ...uint32) Uint32Accessor {
 	nv := &NullUint32{}
 	pv, err := strconv.ParseUint(from, 0, 32)
-	nv.Error = err
+	if err != nil {
+		nv.Error = newConversionError(from, reflect.Uint32, err)
+	}
 	if defaultUint32(nv, defaultValue...) {
 		return nv
 	}
@@ -632,7 +650,9 @@ func StringUint32(from string, defaultValue ...uint32) Uint32Accessor {
 func StringUint16(from string, defaultValue ...uint16) Uint16Accessor {
 	nv := &NullUint16{}
 	pv, err := strconv.ParseUint(from, 0, 16)
-	nv.Error = err
+	if err != nil {
+		nv.Error = newConversionError(from, reflect.Uint16, err)
+	}
 	if defaultUint16(nv, defaultValue...) {
 		return nv
 	}
@@ -646,7 +666,9 @@ func StringUint16(from string, defaultValue ...uint16) Uint16Accessor {
 func StringUint8(from string, defaultValue ...uint8) Uint8Accessor {
 	nv := &NullUint8{}
 	pv, err := strconv.ParseUint(from, 0, 8)
-	nv.Error = err
+	if err != nil {
+		nv.Error = newConversionError(from, reflect.Uint8, err)
+	}
 	if defaultUint8(nv, defaultValue...) {
 		return nv
 	}